@@ -0,0 +1,173 @@
+package alert
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// AlertsCmd groups the commands that manage the alert rules of a project
+var AlertsCmd = &cobra.Command{
+	Use:     "alerts",
+	Aliases: []string{"alert"},
+	Short:   "Manage the alert rules of a project",
+	Long: `Manage the alert rules of a project. A rule fires when a number of events arrive within a time
+span, or with --uptime when an uptime monitor of the project goes down. It notifies the project's
+team members by email, and posts to webhook, Discord and Google Chat URLs.
+
+Alert rules are given by ID or by name.`,
+}
+
+// alertPrinter prints alert rules in every output format
+var alertPrinter = common.ResourcePrinter[glitchtip.ProjectAlert]{
+	Kind: "alert",
+	Name: func(alert glitchtip.ProjectAlert) string { return alert.ID.String() },
+	Columns: []common.Column[glitchtip.ProjectAlert]{
+		{Header: "ID", Value: func(alert glitchtip.ProjectAlert) string { return alert.ID.String() }},
+		{Header: "Name", Value: func(alert glitchtip.ProjectAlert) string { return alert.Name }},
+		{Header: "Condition", Value: Condition},
+		{Header: "Recipients", Value: Recipients},
+	},
+}
+
+// DisplayName returns the name of an alert rule, or its ID when it has
+// none
+func DisplayName(alert glitchtip.ProjectAlert) string {
+	if alert.Name != "" {
+		return alert.Name
+	}
+	return "#" + alert.ID.String()
+}
+
+// Condition describes when an alert rule fires
+func Condition(alert glitchtip.ProjectAlert) string {
+	if alert.Uptime {
+		return "uptime monitor down"
+	}
+	return fmt.Sprintf("%d events in %d min", alert.Quantity, alert.TimespanMinutes)
+}
+
+// Recipients lists where an alert rule sends notifications
+func Recipients(alert glitchtip.ProjectAlert) string {
+	recipients := make([]string, len(alert.AlertRecipients))
+	for i, recipient := range alert.AlertRecipients {
+		recipients[i] = recipient.RecipientType
+		if recipient.URL != "" {
+			recipients[i] += " " + recipient.URL
+		}
+	}
+	return strings.Join(recipients, ", ")
+}
+
+// projectFlags holds the flags that select the project of an alert
+// command
+type projectFlags struct {
+	org     string
+	project string
+}
+
+// add registers --project and --org on cmd
+func (f *projectFlags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.project, "project", "p", "", "Project slug, or <organization>/<project> (required)")
+	common.AddOrgFlag(cmd, &f.org)
+	cmd.MarkFlagRequired("project")
+}
+
+// resolve returns the organization and project slugs
+func (f *projectFlags) resolve() (string, string, error) {
+	return common.ResolveScopedSlug(f.project, f.org)
+}
+
+// recipientFlags are the flags that set the recipients of a rule
+var recipientFlags = []string{"email", "webhook", "discord", "googlechat"}
+
+// settingsFlags holds the flags that configure an alert rule
+type settingsFlags struct {
+	name       string
+	timespan   int
+	quantity   int
+	uptime     bool
+	email      bool
+	webhooks   []string
+	discord    []string
+	googleChat []string
+}
+
+// add registers the settings flags on cmd
+func (f *settingsFlags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.name, "name", "n", "", "Name of the alert rule")
+	cmd.Flags().IntVar(&f.timespan, "timespan-minutes", 0, "Time span the events are counted in, in minutes")
+	cmd.Flags().IntVar(&f.quantity, "quantity", 0, "Number of events within the time span that fires the alert")
+	cmd.Flags().BoolVar(&f.uptime, "uptime", false, "Fire when an uptime monitor of the project goes down")
+	cmd.Flags().BoolVar(&f.email, "email", false, "Notify the members of the project's teams by email")
+	cmd.Flags().StringArrayVar(&f.webhooks, "webhook", nil, "Webhook URL to post to (repeatable)")
+	cmd.Flags().StringArrayVar(&f.discord, "discord", nil, "Discord webhook URL to post to (repeatable)")
+	cmd.Flags().StringArrayVar(&f.googleChat, "googlechat", nil, "Google Chat webhook URL to post to (repeatable)")
+}
+
+// apply writes the settings to payload. Only flags given on the command
+// line are applied; recipient flags replace all recipients.
+func (f *settingsFlags) apply(cmd *cobra.Command, payload *glitchtip.ProjectAlertRequest) error {
+	flags := cmd.Flags()
+	if flags.Changed("name") {
+		payload.Name = f.name
+	}
+	if flags.Changed("timespan-minutes") {
+		payload.TimespanMinutes = f.timespan
+	}
+	if flags.Changed("quantity") {
+		payload.Quantity = f.quantity
+	}
+	if flags.Changed("uptime") {
+		payload.Uptime = f.uptime
+	}
+
+	for _, name := range recipientFlags {
+		if flags.Changed(name) {
+			recipients, err := f.recipients()
+			if err != nil {
+				return err
+			}
+			payload.AlertRecipients = recipients
+			break
+		}
+	}
+
+	if !payload.Uptime && (payload.TimespanMinutes <= 0 || payload.Quantity <= 0) {
+		return common.Validationf("give a positive --quantity and --timespan-minutes, or --uptime")
+	}
+	return nil
+}
+
+// recipients builds the recipients given by the flags
+func (f *settingsFlags) recipients() ([]glitchtip.AlertRecipient, error) {
+	recipients := []glitchtip.AlertRecipient{}
+	if f.email {
+		recipients = append(recipients, glitchtip.AlertRecipient{RecipientType: glitchtip.RecipientEmail})
+	}
+	for _, group := range []struct {
+		kind, flag string
+		urls       []string
+	}{
+		{glitchtip.RecipientWebhook, "webhook", f.webhooks},
+		{glitchtip.RecipientDiscord, "discord", f.discord},
+		{glitchtip.RecipientGoogleChat, "googlechat", f.googleChat},
+	} {
+		for _, target := range group.urls {
+			u, err := url.Parse(target)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, common.Validationf("--%s %q is not an http or https URL", group.flag, target)
+			}
+			recipients = append(recipients, glitchtip.AlertRecipient{RecipientType: group.kind, URL: target})
+		}
+	}
+	return recipients, nil
+}
+
+func init() {
+	AlertsCmd.AddCommand(newListCmd(), newCreateCmd(), newUpdateCmd(), newDeleteCmd())
+}
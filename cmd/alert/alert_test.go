@@ -0,0 +1,80 @@
+package alert
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// applyFlags parses args as settings flags and applies them to payload
+func applyFlags(t *testing.T, payload *glitchtip.ProjectAlertRequest, args ...string) error {
+	t.Helper()
+	var flags settingsFlags
+	cmd := &cobra.Command{}
+	flags.add(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return flags.apply(cmd, payload)
+}
+
+func TestApplySettings(t *testing.T) {
+	var payload glitchtip.ProjectAlertRequest
+	err := applyFlags(t, &payload, "--quantity", "10", "--timespan-minutes", "5", "--email",
+		"--webhook", "https://hooks.example.com/a", "--discord", "https://discord.com/api/webhooks/1", "--googlechat", "https://chat.googleapis.com/v1/spaces/x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []glitchtip.AlertRecipient{
+		{RecipientType: glitchtip.RecipientEmail},
+		{RecipientType: glitchtip.RecipientWebhook, URL: "https://hooks.example.com/a"},
+		{RecipientType: glitchtip.RecipientDiscord, URL: "https://discord.com/api/webhooks/1"},
+		{RecipientType: glitchtip.RecipientGoogleChat, URL: "https://chat.googleapis.com/v1/spaces/x"},
+	}
+	if payload.Quantity != 10 || payload.TimespanMinutes != 5 || !reflect.DeepEqual(payload.AlertRecipients, want) {
+		t.Errorf("payload = %+v", payload)
+	}
+
+	// Settings that are not given stay as they are
+	if err := applyFlags(t, &payload, "--quantity", "50"); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Quantity != 50 || len(payload.AlertRecipients) != 4 {
+		t.Errorf("payload after update = %+v", payload)
+	}
+
+	// Recipient flags replace every recipient
+	if err := applyFlags(t, &payload, "--email"); err != nil {
+		t.Fatal(err)
+	}
+	if len(payload.AlertRecipients) != 1 || payload.AlertRecipients[0].RecipientType != glitchtip.RecipientEmail {
+		t.Errorf("recipients = %+v, want only email", payload.AlertRecipients)
+	}
+
+	for _, args := range [][]string{
+		{"--quantity", "10"},
+		{"--uptime", "--webhook", "hooks.example.com"},
+		{"--uptime", "--discord", "ftp://discord.com/x"},
+	} {
+		payload := glitchtip.ProjectAlertRequest{}
+		if err := applyFlags(t, &payload, args...); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("apply(%v) = %v, want a validation error", args, err)
+		}
+	}
+}
+
+func TestRecipients(t *testing.T) {
+	alert := glitchtip.ProjectAlert{ID: "3", AlertRecipients: []glitchtip.AlertRecipient{
+		{RecipientType: glitchtip.RecipientEmail},
+		{RecipientType: glitchtip.RecipientDiscord, URL: "https://discord.com/api/webhooks/1"},
+	}}
+	if got := Recipients(alert); got != "email, discord https://discord.com/api/webhooks/1" {
+		t.Errorf("Recipients = %q", got)
+	}
+	if got := DisplayName(alert); got != "#3" {
+		t.Errorf("DisplayName = %q, want #3", got)
+	}
+}
@@ -0,0 +1,72 @@
+package alert
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd creates the "create alert" command
+func NewCreateCmd() *cobra.Command {
+	cmd := newCreateCmd()
+	cmd.Use = "alert"
+	cmd.Aliases = []string{"alerts"}
+	return cmd
+}
+
+// newCreateCmd creates the alerts create command
+func newCreateCmd() *cobra.Command {
+	var project projectFlags
+	var flags settingsFlags
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an alert rule for a project",
+		Long: `Create an alert rule for a project. It fires when --quantity events arrive within
+--timespan-minutes, or with --uptime when an uptime monitor of the project goes down. Give at least
+one recipient.`,
+		Example: `  glitchtipctl alerts create --project my-org/web --name Errors --quantity 10 --timespan-minutes 5 --email
+  glitchtipctl alerts create --project web --uptime --discord https://discord.com/api/webhooks/...
+  glitchtipctl alerts create --project web --quantity 1 --timespan-minutes 1 \
+    --webhook https://hooks.example.com/glitchtip --googlechat https://chat.googleapis.com/v1/spaces/...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, projectSlug, err := project.resolve()
+			if err != nil {
+				return err
+			}
+			var payload glitchtip.ProjectAlertRequest
+			if err := flags.apply(cmd, &payload); err != nil {
+				return err
+			}
+			if len(payload.AlertRecipients) == 0 {
+				return common.Validationf("give at least one recipient: --email, --webhook, --discord or --googlechat")
+			}
+
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+			alert, err := common.FetchWithSpinner("Creating alert...", func() (*glitchtip.ProjectAlert, error) {
+				return client.CreateProjectAlert(context.Background(), orgSlug, projectSlug, payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Alert %s created", alert.ID)
+			return alertPrinter.PrintObject(os.Stdout, format, *alert)
+		},
+	}
+
+	project.add(cmd)
+	flags.add(cmd)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
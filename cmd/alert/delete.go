@@ -0,0 +1,65 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the "delete alert" command
+func NewDeleteCmd() *cobra.Command {
+	cmd := newDeleteCmd()
+	cmd.Use = "alert <alert_id|name>"
+	cmd.Aliases = []string{"alerts"}
+	return cmd
+}
+
+// newDeleteCmd creates the alerts delete command
+func newDeleteCmd() *cobra.Command {
+	var flags projectFlags
+
+	cmd := &cobra.Command{
+		Use:   "delete <alert_id|name>",
+		Short: "Delete an alert rule of a project",
+		Long: `Delete an alert rule of a project.
+
+You are asked to type the alert ID again to confirm, unless --yes is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var alertID string
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				alert, err := client.FindProjectAlert(ctx, orgSlug, projectSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				alertID = alert.ID.String()
+				return &common.DeletionPlan{
+					Kind:  "alert",
+					Name:  alertID,
+					Title: alert.Name,
+					Details: []string{
+						"project: " + orgSlug + "/" + projectSlug,
+						"condition: " + Condition(*alert),
+						"recipients: " + Recipients(*alert),
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteProjectAlert(ctx, orgSlug, projectSlug, alertID)
+			})
+		},
+	}
+
+	flags.add(cmd)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,57 @@
+package alert
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the "get alerts" command, the alert list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "alerts"
+	cmd.Aliases = []string{"alert"}
+	return cmd
+}
+
+// newListCmd creates the alerts list command
+func newListCmd() *cobra.Command {
+	var flags projectFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the alert rules of a project",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			alerts, err := common.FetchWithSpinner("Fetching alerts...", func() ([]glitchtip.ProjectAlert, error) {
+				return client.ListProjectAlerts(context.Background(), orgSlug, projectSlug)
+			})
+			if err != nil {
+				return err
+			}
+			return alertPrinter.PrintList(os.Stdout, format, alerts)
+		},
+	}
+
+	flags.add(cmd)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
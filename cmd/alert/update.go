@@ -0,0 +1,77 @@
+package alert
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateCmd creates the alerts update command
+func newUpdateCmd() *cobra.Command {
+	var project projectFlags
+	var flags settingsFlags
+
+	cmd := &cobra.Command{
+		Use:   "update <alert_id|name>",
+		Short: "Change an alert rule of a project",
+		Long: `Change an alert rule of a project. Only the given settings are changed, except that the
+recipient flags replace all recipients of the rule.`,
+		Example: `  glitchtipctl alerts update Errors --project web --quantity 50
+  glitchtipctl alerts update 12 --project web --email --webhook https://hooks.example.com/glitchtip`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			if !settingsChanged(cmd) {
+				return common.Validationf("nothing to update, give at least one setting or recipient")
+			}
+			orgSlug, projectSlug, err := project.resolve()
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			alert, err := common.FetchWithSpinner("Updating alert...", func() (*glitchtip.ProjectAlert, error) {
+				ctx := context.Background()
+				alert, err := client.FindProjectAlert(ctx, orgSlug, projectSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				// The rule is replaced as a whole, so start from its settings
+				payload := alert.Request()
+				if err := flags.apply(cmd, &payload); err != nil {
+					return nil, err
+				}
+				return client.UpdateProjectAlert(ctx, orgSlug, projectSlug, alert.ID.String(), payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Alert %s updated", alert.ID)
+			return alertPrinter.PrintObject(os.Stdout, format, *alert)
+		},
+	}
+
+	project.add(cmd)
+	flags.add(cmd)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// settingsChanged reports whether any setting or recipient flag was given
+func settingsChanged(cmd *cobra.Command) bool {
+	for _, name := range append([]string{"name", "timespan-minutes", "quantity", "uptime"}, recipientFlags...) {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyFiles    []string
+	applyOrg      string
+	applyDryRun   bool
+	applyPrune    bool
+	applySelector string
+	applyYes      bool
+)
+
+// ApplyCmd brings the server to the state described by YAML manifests
+var ApplyCmd = &cobra.Command{
+	Use:   "apply -f <file|directory>...",
+	Short: "Create or update resources from YAML manifests",
+	Long: `Create or update organizations, teams, projects, members, alert rules and uptime monitors so
+that they match YAML manifests, e.g. kept in git. Directories are searched recursively for .yaml and
+.yml files, and "-f -" reads stdin. A file can hold several manifests separated by "---".
+
+The manifests are compared with the server first, and the plan is printed as a table. Resources are
+created or updated in dependency order: organizations, teams, projects each followed by its keys,
+members, then alerts and monitors. Settings a manifest leaves out are not changed, and new members
+are invited with the member role. Use --dry-run to only print the plan.
+
+With --prune, resources that no manifest declares are deleted within the scopes picked by
+--selector: Organization manifests whose labels match are scopes for their teams, projects,
+members and monitors, and Project manifests that match (or whose organization matches) for their
+alert rules, and for their keys if the project lists keys. Deleting asks for confirmation unless
+--yes is given.
+
+Manifest format:
+
+  apiVersion: glitchtipctl/v1
+  kind: Project            # Organization, Team, Project, Member, Alert or Monitor
+  metadata:
+    name: web              # slug, member email, or alert/monitor name
+    organization: my-org   # defaults to --org or the current context
+    labels:
+      env: prod
+  spec:
+    platform: javascript
+    teams: [backend]
+    keys:
+      - name: Default`,
+	Example: `  glitchtipctl apply -f glitchtip/ --dry-run
+  glitchtipctl apply -f glitchtip/ --prune -l env=prod
+  cat manifests/*.yaml | glitchtipctl apply -f -`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runApply(context.Background())
+	},
+}
+
+// runApply plans the manifests, prints the plan and carries it out
+func runApply(ctx context.Context) error {
+	var selector map[string]string
+	if applyPrune {
+		if applySelector == "" {
+			return common.Validationf("--prune needs a --selector that picks the organizations and projects to prune, e.g. -l env=prod")
+		}
+		var err error
+		if selector, err = parseSelector(applySelector); err != nil {
+			return err
+		}
+	} else if applySelector != "" {
+		return common.Validationf("--selector is only used with --prune")
+	}
+
+	manifests, err := loadManifests(applyFiles)
+	if err != nil {
+		return err
+	}
+	if err := resolveOrganizations(manifests, applyOrg); err != nil {
+		return err
+	}
+	client, err := common.NewClient()
+	if err != nil {
+		return err
+	}
+
+	planner := newPlanner(client, manifests)
+	planned, err := common.FetchWithSpinner("Comparing manifests with the server...", func() (*plan, error) {
+		if err := planner.build(ctx); err != nil {
+			return nil, err
+		}
+		if selector != nil {
+			self, err := currentEmail(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+			if err := planner.prune(ctx, selector, self); err != nil {
+				return nil, err
+			}
+		}
+		return &planner.plan, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	planned.print(os.Stdout)
+	if applyDryRun {
+		return nil
+	}
+	if planned.count(actionCreate)+planned.count(actionUpdate)+planned.count(actionDelete) == 0 {
+		common.Infof("Everything is up to date")
+		return nil
+	}
+	if deletions := planned.count(actionDelete); deletions > 0 && !applyYes {
+		if !common.IsInteractive() {
+			return common.Validationf("refusing to delete %d resources without --yes in a non-interactive session", deletions)
+		}
+		ok, err := common.Confirm(fmt.Sprintf("Delete %d resources that no manifest declares?", deletions))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("nothing was applied")
+		}
+	}
+
+	for _, s := range planned.steps {
+		if s.run == nil {
+			continue
+		}
+		_, err := common.FetchWithSpinner(fmt.Sprintf("Applying %s %s...", s.kind, s.name), func() (struct{}, error) {
+			return struct{}{}, s.run(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to %s %s %s: %w", s.action, s.kind, s.name, err)
+		}
+		common.Infof("%s %s: %sd", s.kind, s.name, s.action)
+	}
+	common.Infof("%d created, %d updated, %d deleted", planned.count(actionCreate), planned.count(actionUpdate), planned.count(actionDelete))
+	return nil
+}
+
+func init() {
+	ApplyCmd.Flags().StringSliceVarP(&applyFiles, "filename", "f", nil, "Manifest files or directories to apply, - for stdin")
+	ApplyCmd.MarkFlagRequired("filename")
+	common.AddOrgFlag(ApplyCmd, &applyOrg)
+	ApplyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Only print the plan")
+	ApplyCmd.Flags().BoolVar(&applyPrune, "prune", false, "Delete resources that no manifest declares, within the scopes picked by --selector")
+	ApplyCmd.Flags().StringVarP(&applySelector, "selector", "l", "", "Labels of the organizations and projects to prune, e.g. env=prod")
+	ApplyCmd.Flags().BoolVarP(&applyYes, "yes", "y", false, "Delete without asking for confirmation")
+}
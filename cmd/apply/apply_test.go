@@ -0,0 +1,220 @@
+package apply
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+const testManifests = `
+apiVersion: glitchtipctl/v1
+kind: Organization
+metadata:
+  name: acme
+  labels:
+    env: prod
+spec:
+  require2FA: true
+---
+kind: Team
+metadata:
+  name: ops
+  organization: acme
+---
+kind: Project
+metadata:
+  name: web
+  organization: acme
+spec:
+  platform: node
+  teams: [ops]
+  keys:
+    - name: Default
+---
+kind: Monitor
+metadata:
+  name: API
+  organization: acme
+spec:
+  url: https://api.example.com
+  interval: 2m
+---
+kind: Member
+metadata:
+  name: Ann@Example.com
+  organization: acme
+---
+`
+
+func decodeTestManifests(t *testing.T, input string) []*manifest {
+	t.Helper()
+	manifests, err := decodeManifests(strings.NewReader(input), "test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resolveOrganizations(manifests, ""); err != nil {
+		t.Fatal(err)
+	}
+	return manifests
+}
+
+func TestDecodeManifests(t *testing.T) {
+	manifests := decodeTestManifests(t, testManifests)
+	if len(manifests) != 5 {
+		t.Fatalf("decoded %d manifests, want 5", len(manifests))
+	}
+
+	project := manifests[2].spec.(*projectSpec)
+	if project.Platform != "node" || !reflect.DeepEqual(project.Teams, []string{"ops"}) || project.Keys[0].Name != "Default" {
+		t.Errorf("project spec = %+v", project)
+	}
+	if monitor := manifests[3].spec.(*monitorSpec); monitor.Type != "http" || monitor.Interval == nil || *monitor.Interval != 120 || monitor.Paused != nil {
+		t.Errorf("monitor spec = %+v, want the http type, 120 seconds and no paused setting", monitor)
+	}
+	if member := manifests[4].spec.(*memberSpec); member.Role != "" {
+		t.Errorf("member role = %q, want none so that the current role is kept", member.Role)
+	}
+	if got := manifests[2].path(); got != "acme/web" {
+		t.Errorf("path = %q", got)
+	}
+	if !manifests[0].matches(map[string]string{"env": "prod"}) || manifests[0].matches(map[string]string{"env": "dev"}) {
+		t.Error("the organization labels do not match the selector")
+	}
+}
+
+func TestDecodeManifestErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown kind":      "kind: Widget\nmetadata: {name: x}\n",
+		"unknown field":     "kind: Project\nmetadata: {name: web}\nspec: {platfrom: node}\n",
+		"missing name":      "kind: Team\nmetadata: {organization: acme}\n",
+		"api version":       "apiVersion: v2\nkind: Team\nmetadata: {name: ops}\n",
+		"bad slug":          "kind: Team\nmetadata: {name: Ops Team}\n",
+		"bad role":          "kind: Member\nmetadata: {name: ann@example.com}\nspec: {role: boss}\n",
+		"alert project":     "kind: Alert\nmetadata: {name: Errors}\nspec: {uptime: true, recipients: [{type: email}]}\n",
+		"alert recipients":  "kind: Alert\nmetadata: {name: Errors, project: web}\nspec: {uptime: true}\n",
+		"recipient url":     "kind: Alert\nmetadata: {name: Errors, project: web}\nspec: {uptime: true, recipients: [{type: webhook}]}\n",
+		"monitor interval":  "kind: Monitor\nmetadata: {name: API}\nspec: {url: https://x, interval: 1.5s}\n",
+		"monitor type":      "kind: Monitor\nmetadata: {name: API}\nspec: {type: tcp, url: https://x}\n",
+		"throttle rate":     "kind: Project\nmetadata: {name: web}\nspec: {eventThrottleRate: 120}\n",
+		"duplicate key":     "kind: Project\nmetadata: {name: web}\nspec: {keys: [{name: a}, {name: a}]}\n",
+		"missing key name":  "kind: Project\nmetadata: {name: web}\nspec: {keys: [{active: true}]}\n",
+		"member email":      "kind: Member\nmetadata: {name: ann}\n",
+		"project on a team": "kind: Team\nmetadata: {name: ops, project: web}\n",
+	}
+	for name, input := range tests {
+		if _, err := decodeManifests(strings.NewReader(input), "test.yaml"); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("%s: decodeManifests = %v, want a validation error", name, err)
+		}
+	}
+
+	manifests, err := decodeManifests(strings.NewReader("kind: Team\nmetadata: {name: ops, organization: acme}\n---\nkind: Team\nmetadata: {name: ops, organization: acme}\n"), "test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resolveOrganizations(manifests, ""); common.ExitCode(err) != common.ExitValidation {
+		t.Errorf("resolveOrganizations of a duplicate = %v, want a validation error", err)
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	selector, err := parseSelector("env=prod, team=web")
+	if err != nil || !reflect.DeepEqual(selector, map[string]string{"env": "prod", "team": "web"}) {
+		t.Errorf("parseSelector = %v, %v", selector, err)
+	}
+	for _, value := range []string{"env", "=prod", "env=prod,"} {
+		if _, err := parseSelector(value); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("parseSelector(%q) = %v, want a validation error", value, err)
+		}
+	}
+}
+
+func TestPlan(t *testing.T) {
+	responses := map[string]string{
+		"/api/0/organizations/acme/":          `{"slug":"acme","name":"Acme","require2FA":false}`,
+		"/api/0/projects/acme/web/":           `{"id":"3","slug":"web","name":"web","platform":"python","teams":[{"slug":"ops"},{"slug":"old"}]}`,
+		"/api/0/projects/acme/web/keys/":      `[{"id":"k1","name":"Default","isActive":true},{"id":"k2","name":"Legacy","isActive":true}]`,
+		"/api/0/projects/acme/web/alerts/":    `[{"id":4,"name":"Errors","uptime":true,"alertRecipients":[{"recipientType":"email"}]}]`,
+		"/api/0/organizations/acme/monitors/": `[{"id":5,"name":"API","monitorType":"GET","url":"https://api.example.com","interval":60,"expectedStatus":200,"isPaused":true}]`,
+		"/api/0/organizations/acme/members/":  `[{"id":7,"email":"ann@example.com","role":"admin","teams":["ops"]},{"id":8,"email":"me@example.com","role":"owner"},{"id":9,"email":"bob@example.com","role":"member"}]`,
+		"/api/0/organizations/acme/teams/":    `[{"slug":"old"}]`,
+		"/api/0/organizations/acme/projects/": `[{"slug":"web"},{"slug":"legacy"}]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	planner := newPlanner(glitchtip.NewClient(server.URL, "token"), decodeTestManifests(t, testManifests))
+	ctx := context.Background()
+	if err := planner.build(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := planner.prune(ctx, map[string]string{"env": "prod"}, "me@example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, s := range planner.plan.steps {
+		line := s.action + " " + s.kind + " " + s.name
+		for _, change := range s.changes {
+			line += " " + change.Field + "=" + change.Before + ">" + change.After
+		}
+		got = append(got, line)
+	}
+	want := []string{
+		"update Organization acme require-2fa=false>true",
+		"create Team acme/ops",
+		"update Project acme/web platform=python>node teams=old,ops>ops",
+		"unchanged Key acme/web/Default",
+		"unchanged Member acme/Ann@Example.com",
+		"update Monitor acme/API interval=60>120",
+		"delete Alert acme/web/Errors",
+		"delete Key acme/web/Legacy",
+		"delete Member acme/bob@example.com",
+		"delete Project acme/legacy",
+		"delete Team acme/old",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("plan =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestPlanKeyUpdateKeepsRateLimit(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := `{"id":"k1","name":"Default","isActive":true,"rateLimit":{"window":60,"count":100}}`
+		if r.Method == http.MethodPut {
+			data, _ := io.ReadAll(r.Body)
+			body = string(data)
+			w.Write([]byte(key))
+			return
+		}
+		w.Write([]byte("[" + key + "]"))
+	}))
+	defer server.Close()
+
+	manifests := decodeTestManifests(t, "kind: Project\nmetadata: {name: web, organization: acme}\nspec:\n  keys:\n    - {name: Default, active: false}\n")
+	planner := newPlanner(glitchtip.NewClient(server.URL, "token"), manifests)
+	ctx := context.Background()
+	if err := planner.planKeys(ctx, manifests[0], manifests[0].spec.(*projectSpec)); err != nil {
+		t.Fatal(err)
+	}
+	if err := planner.plan.steps[0].run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"Default","isActive":false,"rateLimit":{"window":60,"count":100}}`; strings.TrimSpace(body) != want {
+		t.Errorf("update payload = %s, want %s", body, want)
+	}
+}
@@ -0,0 +1,440 @@
+package apply
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"gopkg.in/yaml.v3"
+)
+
+// apiVersion is the version of the manifest format. Manifests may leave
+// it out.
+const apiVersion = "glitchtipctl/v1"
+
+// Manifest kinds, in the order they are applied.
+const (
+	kindOrganization = "Organization"
+	kindTeam         = "Team"
+	kindProject      = "Project"
+	kindMember       = "Member"
+	kindKey          = "Key"
+	kindAlert        = "Alert"
+	kindMonitor      = "Monitor"
+)
+
+// manifest is one YAML document describing a resource
+type manifest struct {
+	APIVersion string    `yaml:"apiVersion"`
+	Kind       string    `yaml:"kind"`
+	Metadata   metadata  `yaml:"metadata"`
+	Spec       yaml.Node `yaml:"spec"`
+
+	// source names the file and document the manifest was read from
+	source string
+	// spec is the decoded Spec, a pointer to the spec type of the kind
+	spec interface{}
+}
+
+// metadata identifies a resource. Name is the slug of organizations,
+// teams and projects, the email of members and the name of alerts and
+// monitors.
+type metadata struct {
+	Name         string            `yaml:"name"`
+	Organization string            `yaml:"organization"`
+	Project      string            `yaml:"project"`
+	Labels       map[string]string `yaml:"labels"`
+}
+
+// organizationSpec is the desired state of an organization. Unset fields
+// are left as they are.
+type organizationSpec struct {
+	Name            string `yaml:"name"`
+	Require2FA      *bool  `yaml:"require2FA"`
+	OpenMembership  *bool  `yaml:"openMembership"`
+	AcceptingEvents *bool  `yaml:"acceptingEvents"`
+}
+
+// teamSpec is the desired state of a team, which has no settings.
+type teamSpec struct{}
+
+// projectSpec is the desired state of a project. Teams and Keys are only
+// managed when they are set.
+type projectSpec struct {
+	Name              string    `yaml:"name"`
+	Platform          string    `yaml:"platform"`
+	Teams             []string  `yaml:"teams"`
+	EventThrottleRate *int      `yaml:"eventThrottleRate"`
+	ScrubIPAddresses  *bool     `yaml:"scrubIPAddresses"`
+	Keys              []keySpec `yaml:"keys"`
+}
+
+// keySpec is a client key of a project, identified by name.
+type keySpec struct {
+	Name   string `yaml:"name"`
+	Active *bool  `yaml:"active"`
+}
+
+// memberSpec is the desired state of an organization member. The role and
+// teams are only managed when they are set; new members are invited with
+// the member role.
+type memberSpec struct {
+	Role  string   `yaml:"role"`
+	Teams []string `yaml:"teams"`
+}
+
+// alertSpec is the desired state of an alert rule.
+type alertSpec struct {
+	TimespanMinutes int             `yaml:"timespanMinutes"`
+	Quantity        int             `yaml:"quantity"`
+	Uptime          bool            `yaml:"uptime"`
+	Recipients      []recipientSpec `yaml:"recipients"`
+}
+
+// recipientSpec is where an alert is sent.
+type recipientSpec struct {
+	Type string `yaml:"type"`
+	URL  string `yaml:"url"`
+}
+
+// monitorSpec is the desired state of an uptime monitor. Unset settings
+// keep their current values; new monitors check every minute.
+type monitorSpec struct {
+	Type           string   `yaml:"type"`
+	URL            string   `yaml:"url"`
+	Interval       *seconds `yaml:"interval"`
+	Timeout        seconds  `yaml:"timeout"`
+	ExpectedStatus *int     `yaml:"expectedStatus"`
+	Keyword        string   `yaml:"keyword"`
+	Project        string   `yaml:"project"`
+	Paused         *bool    `yaml:"paused"`
+}
+
+// seconds is a duration given as a number of seconds or as e.g. "5m"
+type seconds int
+
+func (s *seconds) UnmarshalYAML(node *yaml.Node) error {
+	duration, err := time.ParseDuration(node.Value)
+	if n, convErr := strconv.Atoi(node.Value); convErr == nil {
+		duration, err = time.Duration(n)*time.Second, nil
+	}
+	if err != nil || duration < time.Second || duration%time.Second != 0 {
+		return fmt.Errorf("%q must be a whole number of seconds or a duration such as 30s or 5m", node.Value)
+	}
+	*s = seconds(duration / time.Second)
+	return nil
+}
+
+// monitorTypes maps the monitor types of manifests to the server's
+var monitorTypes = map[string]string{
+	"http":      glitchtip.MonitorTypeGET,
+	"keyword":   glitchtip.MonitorTypeGET,
+	"post":      glitchtip.MonitorTypePOST,
+	"ping":      glitchtip.MonitorTypePing,
+	"ssl":       glitchtip.MonitorTypeSSL,
+	"heartbeat": glitchtip.MonitorTypeHeartbeat,
+}
+
+// newSpec returns an empty spec of a kind
+func newSpec(kind string) (interface{}, bool) {
+	switch kind {
+	case kindOrganization:
+		return &organizationSpec{}, true
+	case kindTeam:
+		return &teamSpec{}, true
+	case kindProject:
+		return &projectSpec{}, true
+	case kindMember:
+		return &memberSpec{}, true
+	case kindAlert:
+		return &alertSpec{}, true
+	case kindMonitor:
+		return &monitorSpec{}, true
+	}
+	return nil, false
+}
+
+// loadManifests reads the manifests of files and directories. Directories
+// are searched recursively for .yaml and .yml files, and "-" reads stdin.
+func loadManifests(paths []string) ([]*manifest, error) {
+	var manifests []*manifest
+	for _, path := range paths {
+		if path == "-" {
+			loaded, err := decodeManifests(os.Stdin, "stdin")
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, loaded...)
+			continue
+		}
+		err := filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				return nil
+			}
+			// Files given by name are read whatever their extension
+			if ext := filepath.Ext(file); file != path && ext != ".yaml" && ext != ".yml" {
+				return nil
+			}
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			loaded, err := decodeManifests(f, file)
+			if err != nil {
+				return err
+			}
+			manifests = append(manifests, loaded...)
+			return nil
+		})
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, common.Validationf("%v", err)
+			}
+			return nil, err
+		}
+	}
+	if len(manifests) == 0 {
+		return nil, common.Validationf("no manifests found in %s", strings.Join(paths, ", "))
+	}
+	return manifests, nil
+}
+
+// decodeManifests decodes the YAML documents of r. Unknown fields are
+// errors, so that typos do not silently leave settings unmanaged.
+func decodeManifests(r io.Reader, name string) ([]*manifest, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	var manifests []*manifest
+	for doc := 1; ; doc++ {
+		var m manifest
+		err := decoder.Decode(&m)
+		if err == io.EOF {
+			return manifests, nil
+		}
+		source := fmt.Sprintf("%s (document %d)", name, doc)
+		if err != nil {
+			return nil, common.Validationf("%s: %v", source, err)
+		}
+		// Skip empty documents, e.g. after a trailing "---"
+		if m.Kind == "" && m.Metadata.Name == "" && m.Spec.Kind == 0 {
+			continue
+		}
+		m.source = source
+		if err := m.decodeSpec(); err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, &m)
+	}
+}
+
+// decodeSpec checks the header of a manifest and decodes its spec
+func (m *manifest) decodeSpec() error {
+	if m.APIVersion != "" && m.APIVersion != apiVersion {
+		return common.Validationf("%s: unsupported apiVersion %q, expected %s", m.source, m.APIVersion, apiVersion)
+	}
+	spec, ok := newSpec(m.Kind)
+	if !ok {
+		return common.Validationf("%s: unknown kind %q, expected Organization, Team, Project, Member, Alert or Monitor", m.source, m.Kind)
+	}
+	if m.Metadata.Name == "" {
+		return common.Validationf("%s: %s without metadata.name", m.source, m.Kind)
+	}
+
+	if m.Spec.Kind != 0 {
+		// Decode the spec again with a decoder, which rejects unknown fields
+		encoded, err := yaml.Marshal(&m.Spec)
+		if err != nil {
+			return err
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(encoded))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(spec); err != nil {
+			return common.Validationf("%s: spec of %s %q: %s", m.source, m.Kind, m.Metadata.Name, specError(err))
+		}
+	}
+	m.spec = spec
+	if err := m.validate(); err != nil {
+		return common.Validationf("%s: %s %q: %v", m.source, m.Kind, m.Metadata.Name, err)
+	}
+	return nil
+}
+
+// specErrorDetail matches the parts of decoding errors that refer to the
+// re-encoded spec and to Go types rather than to the manifest
+var specErrorDetail = regexp.MustCompile(`^line \d+: | in type apply\.\w+`)
+
+// specError describes an error decoding a spec in terms of the manifest
+func specError(err error) string {
+	var typeErr *yaml.TypeError
+	if !errors.As(err, &typeErr) {
+		return err.Error()
+	}
+	messages := make([]string, len(typeErr.Errors))
+	for i, message := range typeErr.Errors {
+		messages[i] = specErrorDetail.ReplaceAllString(message, "")
+	}
+	return strings.Join(messages, "; ")
+}
+
+// validate checks the settings of a manifest that the server would reject
+// only after earlier resources were applied
+func (m *manifest) validate() error {
+	if m.Kind == kindAlert && m.Metadata.Project == "" {
+		return errors.New("metadata.project is required")
+	}
+	if m.Kind != kindAlert && m.Metadata.Project != "" {
+		return errors.New("metadata.project is only used by alerts")
+	}
+
+	switch spec := m.spec.(type) {
+	case *organizationSpec, *teamSpec:
+		if m.Metadata.Name != glitchtip.Slugify(m.Metadata.Name) {
+			return errors.New("metadata.name must be a slug of lowercase letters, digits and dashes")
+		}
+	case *projectSpec:
+		if m.Metadata.Name != glitchtip.Slugify(m.Metadata.Name) {
+			return errors.New("metadata.name must be a slug of lowercase letters, digits and dashes")
+		}
+		if spec.EventThrottleRate != nil && (*spec.EventThrottleRate < 0 || *spec.EventThrottleRate > 100) {
+			return errors.New("eventThrottleRate must be between 0 and 100")
+		}
+		names := map[string]bool{}
+		for _, key := range spec.Keys {
+			if key.Name == "" {
+				return errors.New("every key needs a name")
+			}
+			if names[key.Name] {
+				return fmt.Errorf("key %q is listed twice", key.Name)
+			}
+			names[key.Name] = true
+		}
+	case *memberSpec:
+		if _, err := mail.ParseAddress(m.Metadata.Name); err != nil {
+			return errors.New("metadata.name must be the email address of the member")
+		}
+		if spec.Role != "" && !contains(glitchtip.Roles, spec.Role) {
+			return fmt.Errorf("unknown role %q, expected one of %s", spec.Role, strings.Join(glitchtip.Roles, ", "))
+		}
+	case *alertSpec:
+		if !spec.Uptime && (spec.Quantity < 1 || spec.TimespanMinutes < 1) {
+			return errors.New("give uptime: true, or a quantity and timespanMinutes of at least 1")
+		}
+		if len(spec.Recipients) == 0 {
+			return errors.New("at least one recipient is required")
+		}
+		for _, recipient := range spec.Recipients {
+			switch recipient.Type {
+			case glitchtip.RecipientEmail:
+			case glitchtip.RecipientWebhook, glitchtip.RecipientDiscord, glitchtip.RecipientGoogleChat:
+				if recipient.URL == "" {
+					return fmt.Errorf("%s recipients need a url", recipient.Type)
+				}
+			default:
+				return fmt.Errorf("unknown recipient type %q, expected email, webhook, discord or googlechat", recipient.Type)
+			}
+		}
+	case *monitorSpec:
+		if spec.Type == "" {
+			spec.Type = "http"
+		}
+		if _, ok := monitorTypes[spec.Type]; !ok {
+			return fmt.Errorf("unknown monitor type %q, expected http, keyword, post, ping, ssl or heartbeat", spec.Type)
+		}
+		if spec.Type != "heartbeat" && spec.URL == "" {
+			return fmt.Errorf("%s monitors need a url", spec.Type)
+		}
+		if spec.Type == "keyword" && spec.Keyword == "" {
+			return errors.New("keyword monitors need a keyword")
+		}
+	}
+	return nil
+}
+
+// resolveOrganizations fills in the organization of manifests that leave
+// it out from the current context, and rejects resources declared twice
+func resolveOrganizations(manifests []*manifest, orgFlag string) error {
+	seen := map[string]string{}
+	for _, m := range manifests {
+		if m.Kind == kindOrganization {
+			if m.Metadata.Organization != "" && m.Metadata.Organization != m.Metadata.Name {
+				return common.Validationf("%s: an Organization is named by metadata.name only", m.source)
+			}
+			m.Metadata.Organization = m.Metadata.Name
+		}
+		if m.Metadata.Organization == "" {
+			org, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return fmt.Errorf("%s: %w", m.source, err)
+			}
+			m.Metadata.Organization = org
+		}
+		id := m.Kind + " " + m.path()
+		if previous, ok := seen[id]; ok {
+			return common.Validationf("%s: %s %s is also declared in %s", m.source, m.Kind, m.path(), previous)
+		}
+		seen[id] = m.source
+	}
+	return nil
+}
+
+// path names a resource within its organization and project, e.g.
+// "my-org/web/Errors"
+func (m *manifest) path() string {
+	parts := []string{m.Metadata.Organization}
+	if m.Metadata.Project != "" {
+		parts = append(parts, m.Metadata.Project)
+	}
+	if m.Kind != kindOrganization {
+		parts = append(parts, m.Metadata.Name)
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseSelector parses a label selector such as "env=prod,team=web"
+func parseSelector(selector string) (map[string]string, error) {
+	labels := map[string]string{}
+	for _, term := range strings.Split(selector, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(term), "=")
+		if !ok || key == "" {
+			return nil, common.Validationf("invalid selector %q, expected key=value pairs such as env=prod", selector)
+		}
+		labels[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return labels, nil
+}
+
+// matches reports whether a manifest has every label of the selector
+func (m *manifest) matches(selector map[string]string) bool {
+	for key, value := range selector {
+		if label, ok := m.Metadata.Labels[key]; !ok || label != value {
+			return false
+		}
+	}
+	return true
+}
+
+// contains reports whether values holds value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
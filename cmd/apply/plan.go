@@ -0,0 +1,657 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/cmd/alert"
+	organizationcmd "github.com/nanyte25/glitchtipctl/cmd/organization"
+	projectcmd "github.com/nanyte25/glitchtipctl/cmd/project"
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// Actions of a plan step.
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionDelete    = "delete"
+	actionUnchanged = "unchanged"
+)
+
+// step is one change to the server, or a resource that is already as
+// declared
+type step struct {
+	action  string
+	kind    string
+	name    string
+	changes []common.Change
+	run     func(ctx context.Context) error
+}
+
+// plan is the list of steps that bring the server to the declared state,
+// in the order they run
+type plan struct {
+	steps []step
+}
+
+// count returns how many steps have the action
+func (p *plan) count(action string) int {
+	n := 0
+	for _, s := range p.steps {
+		if s.action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// print writes the steps as a table, one row per resource
+func (p *plan) print(w io.Writer) {
+	table := common.NewTable(w, []string{"Action", "Kind", "Name", "Changes"})
+	table.SetAutoWrapText(false)
+	for _, s := range p.steps {
+		changes := make([]string, len(s.changes))
+		for i, change := range s.changes {
+			changes[i] = fmt.Sprintf("%s: %s -> %s", change.Field, orNone(change.Before), orNone(change.After))
+		}
+		table.Append([]string{s.action, s.kind, s.name, strings.Join(changes, "; ")})
+	}
+	table.Render()
+}
+
+// orNone shows empty values in change lists
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// planner compares manifests with the live server. It caches what it
+// looked up, and remembers the organizations and projects that do not
+// exist yet, whose contents are all created.
+type planner struct {
+	client    *glitchtip.Client
+	manifests []*manifest
+
+	newOrgs     map[string]bool
+	newProjects map[string]bool
+	projects    map[string]*glitchtip.Project
+	members     map[string][]glitchtip.Member
+	monitors    map[string][]glitchtip.Monitor
+
+	plan plan
+}
+
+// newPlanner returns a planner for manifests with resolved organizations
+func newPlanner(client *glitchtip.Client, manifests []*manifest) *planner {
+	return &planner{
+		client:      client,
+		manifests:   manifests,
+		newOrgs:     map[string]bool{},
+		newProjects: map[string]bool{},
+		projects:    map[string]*glitchtip.Project{},
+		members:     map[string][]glitchtip.Member{},
+		monitors:    map[string][]glitchtip.Monitor{},
+	}
+}
+
+// build plans every manifest, parents before the resources they contain
+func (p *planner) build(ctx context.Context) error {
+	for _, kind := range []string{kindOrganization, kindTeam, kindProject, kindMember, kindAlert, kindMonitor} {
+		for _, m := range p.manifests {
+			if m.Kind != kind {
+				continue
+			}
+			var err error
+			switch spec := m.spec.(type) {
+			case *organizationSpec:
+				err = p.planOrganization(ctx, m, spec)
+			case *teamSpec:
+				err = p.planTeam(ctx, m)
+			case *projectSpec:
+				err = p.planProject(ctx, m, spec)
+			case *memberSpec:
+				err = p.planMember(ctx, m, spec)
+			case *alertSpec:
+				err = p.planAlert(ctx, m, spec)
+			case *monitorSpec:
+				err = p.planMonitor(ctx, m, spec)
+			}
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", m.Kind, m.path(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// add appends a step, or an unchanged step when there is nothing to do
+func (p *planner) add(s step) {
+	if s.action == actionUpdate && len(s.changes) == 0 {
+		s.action, s.run = actionUnchanged, nil
+	}
+	p.plan.steps = append(p.plan.steps, s)
+}
+
+// organizationUpdate returns the payload that changes org to spec, and
+// the organization as it looks afterwards
+func organizationUpdate(spec *organizationSpec, org glitchtip.Organization) (glitchtip.OrganizationUpdateRequest, glitchtip.Organization) {
+	var payload glitchtip.OrganizationUpdateRequest
+	if spec.Name != "" && spec.Name != org.Name {
+		payload.Name, org.Name = &spec.Name, spec.Name
+	}
+	if spec.Require2FA != nil && *spec.Require2FA != org.Require2FA {
+		payload.Require2FA, org.Require2FA = spec.Require2FA, *spec.Require2FA
+	}
+	if spec.OpenMembership != nil && *spec.OpenMembership != org.OpenMembership {
+		payload.OpenMembership, org.OpenMembership = spec.OpenMembership, *spec.OpenMembership
+	}
+	if spec.AcceptingEvents != nil && *spec.AcceptingEvents != org.IsAcceptingEvents {
+		payload.IsAcceptingEvents, org.IsAcceptingEvents = spec.AcceptingEvents, *spec.AcceptingEvents
+	}
+	return payload, org
+}
+
+func (p *planner) planOrganization(ctx context.Context, m *manifest, spec *organizationSpec) error {
+	slug := m.Metadata.Name
+	live, err := p.client.GetOrganization(ctx, slug)
+	if glitchtip.IsNotFound(err) {
+		p.newOrgs[slug] = true
+		p.add(step{action: actionCreate, kind: m.Kind, name: m.path(), run: func(ctx context.Context) error {
+			name := spec.Name
+			if name == "" {
+				name = slug
+			}
+			created, err := p.client.CreateOrganization(ctx, glitchtip.OrganizationCreateRequest{Name: name, Slug: slug})
+			if err != nil {
+				return err
+			}
+			if payload, _ := organizationUpdate(spec, *created); payload != (glitchtip.OrganizationUpdateRequest{}) {
+				_, err = p.client.UpdateOrganization(ctx, slug, payload)
+			}
+			return err
+		}})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	payload, after := organizationUpdate(spec, *live)
+	p.add(step{action: actionUpdate, kind: m.Kind, name: m.path(), changes: common.Diff(organizationcmd.Fields, *live, after), run: func(ctx context.Context) error {
+		_, err := p.client.UpdateOrganization(ctx, slug, payload)
+		return err
+	}})
+	return nil
+}
+
+func (p *planner) planTeam(ctx context.Context, m *manifest) error {
+	org, slug := m.Metadata.Organization, m.Metadata.Name
+	create := step{action: actionCreate, kind: m.Kind, name: m.path(), run: func(ctx context.Context) error {
+		_, err := p.client.CreateTeam(ctx, org, glitchtip.TeamCreateRequest{Slug: slug})
+		return err
+	}}
+	if p.newOrgs[org] {
+		p.add(create)
+		return nil
+	}
+	_, err := p.client.GetTeam(ctx, org, slug)
+	if glitchtip.IsNotFound(err) {
+		p.add(create)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p.add(step{action: actionUnchanged, kind: m.Kind, name: m.path()})
+	return nil
+}
+
+// projectFields are the settings of a project that apply compares: the
+// ones of update, and the teams
+var projectFields = append(append([]common.Column[glitchtip.Project](nil), projectcmd.Fields...), common.Column[glitchtip.Project]{
+	Header: "teams",
+	Value:  func(project glitchtip.Project) string { return strings.Join(sorted(projectTeams(&project)), ",") },
+})
+
+// projectUpdate returns the payload that changes the settings of project
+// to spec, and the project as it looks afterwards
+func projectUpdate(spec *projectSpec, project glitchtip.Project) (glitchtip.ProjectUpdateRequest, glitchtip.Project) {
+	var payload glitchtip.ProjectUpdateRequest
+	if spec.Name != "" && spec.Name != project.Name {
+		payload.Name, project.Name = &spec.Name, spec.Name
+	}
+	if spec.Platform != "" && spec.Platform != project.Platform {
+		payload.Platform, project.Platform = &spec.Platform, spec.Platform
+	}
+	if spec.EventThrottleRate != nil && *spec.EventThrottleRate != project.EventThrottleRate {
+		payload.EventThrottleRate, project.EventThrottleRate = spec.EventThrottleRate, *spec.EventThrottleRate
+	}
+	if spec.ScrubIPAddresses != nil && *spec.ScrubIPAddresses != project.ScrubIPAddresses {
+		payload.ScrubIPAddresses, project.ScrubIPAddresses = spec.ScrubIPAddresses, *spec.ScrubIPAddresses
+	}
+	if spec.Teams != nil {
+		project.Teams = make([]glitchtip.ProjectTeam, len(spec.Teams))
+		for i, team := range spec.Teams {
+			project.Teams[i] = glitchtip.ProjectTeam{Slug: team}
+		}
+	}
+	return payload, project
+}
+
+func (p *planner) planProject(ctx context.Context, m *manifest, spec *projectSpec) error {
+	org, slug := m.Metadata.Organization, m.Metadata.Name
+	var live *glitchtip.Project
+	if !p.newOrgs[org] {
+		var err error
+		live, err = p.client.GetProject(ctx, org, slug)
+		if err != nil && !glitchtip.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if live == nil {
+		if len(spec.Teams) == 0 {
+			return common.Validationf("a new project needs at least one team in spec.teams")
+		}
+		p.newProjects[org+"/"+slug] = true
+		p.add(step{action: actionCreate, kind: m.Kind, name: m.path(), run: func(ctx context.Context) error {
+			name := spec.Name
+			if name == "" {
+				name = slug
+			}
+			created, err := p.client.CreateProject(ctx, org, spec.Teams[0], glitchtip.ProjectCreateRequest{Name: name, Slug: slug, Platform: spec.Platform})
+			if err != nil {
+				return err
+			}
+			if payload, _ := projectUpdate(spec, *created); payload != (glitchtip.ProjectUpdateRequest{}) {
+				if _, err := p.client.UpdateProject(ctx, org, slug, payload); err != nil {
+					return err
+				}
+			}
+			for _, team := range spec.Teams[1:] {
+				if _, err := p.client.AddTeamProject(ctx, org, team, slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}})
+	} else {
+		p.projects[org+"/"+slug] = live
+		payload, after := projectUpdate(spec, *live)
+		current := projectTeams(live)
+		p.add(step{action: actionUpdate, kind: m.Kind, name: m.path(), changes: common.Diff(projectFields, *live, after), run: func(ctx context.Context) error {
+			if payload != (glitchtip.ProjectUpdateRequest{}) {
+				if _, err := p.client.UpdateProject(ctx, org, slug, payload); err != nil {
+					return err
+				}
+			}
+			for _, team := range spec.Teams {
+				if !contains(current, team) {
+					if _, err := p.client.AddTeamProject(ctx, org, team, slug); err != nil {
+						return err
+					}
+				}
+			}
+			for _, team := range current {
+				if spec.Teams != nil && !contains(spec.Teams, team) {
+					if err := p.client.RemoveTeamProject(ctx, org, team, slug); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}})
+	}
+	return p.planKeys(ctx, m, spec)
+}
+
+// planKeys plans the client keys listed by a project
+func (p *planner) planKeys(ctx context.Context, m *manifest, spec *projectSpec) error {
+	org, slug := m.Metadata.Organization, m.Metadata.Name
+	var live []glitchtip.ProjectKey
+	if len(spec.Keys) > 0 && !p.newProjects[org+"/"+slug] {
+		var err error
+		if live, err = p.client.ListProjectKeys(ctx, org, slug); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range spec.Keys {
+		key := key
+		name := m.path() + "/" + key.Name
+		existing := findKey(live, key.Name)
+		if existing == nil {
+			p.add(step{action: actionCreate, kind: kindKey, name: name, run: func(ctx context.Context) error {
+				// New projects come with a key, which may be the declared one
+				keys, err := p.client.ListProjectKeys(ctx, org, slug)
+				if err != nil {
+					return err
+				}
+				if findKey(keys, key.Name) == nil {
+					_, err = p.client.CreateProjectKey(ctx, org, slug, glitchtip.ProjectKeyRequest{Name: key.Name, IsActive: key.Active})
+				}
+				return err
+			}})
+			continue
+		}
+		s := step{action: actionUpdate, kind: kindKey, name: name}
+		if key.Active != nil && *key.Active != existing.IsActive {
+			s.changes = []common.Change{{Field: "active", Before: strconv.FormatBool(existing.IsActive), After: strconv.FormatBool(*key.Active)}}
+			id := existing.ID.String()
+			s.run = func(ctx context.Context) error {
+				// The key is replaced as a whole, so carry over the rest
+				payload := glitchtip.ProjectKeyRequest{Name: existing.Name, IsActive: key.Active, RateLimit: existing.RateLimit}
+				_, err := p.client.UpdateProjectKey(ctx, org, slug, id, payload)
+				return err
+			}
+		}
+		p.add(s)
+	}
+	return nil
+}
+
+// findKey returns the key with the name, nil if there is none
+func findKey(keys []glitchtip.ProjectKey, name string) *glitchtip.ProjectKey {
+	for i := range keys {
+		if keys[i].Name == name {
+			return &keys[i]
+		}
+	}
+	return nil
+}
+
+// memberFields are the settings of a member that apply compares
+var memberFields = []common.Column[glitchtip.Member]{
+	{Header: "role", Value: func(member glitchtip.Member) string { return member.Role }},
+	{Header: "teams", Value: func(member glitchtip.Member) string { return strings.Join(sorted(member.Teams), ",") }},
+}
+
+func (p *planner) planMember(ctx context.Context, m *manifest, spec *memberSpec) error {
+	org, email := m.Metadata.Organization, m.Metadata.Name
+	members, err := p.orgMembers(ctx, org)
+	if err != nil {
+		return err
+	}
+
+	var live *glitchtip.Member
+	for i := range members {
+		if strings.EqualFold(members[i].Email, email) {
+			live = &members[i]
+		}
+	}
+	if live == nil {
+		role := spec.Role
+		if role == "" {
+			role = glitchtip.RoleMember
+		}
+		p.add(step{action: actionCreate, kind: m.Kind, name: m.path(), run: func(ctx context.Context) error {
+			_, err := p.client.InviteMember(ctx, org, glitchtip.MemberInviteRequest{
+				Email:      email,
+				OrgRole:    role,
+				TeamRoles:  teamRoles(spec.Teams),
+				SendInvite: true,
+			})
+			return err
+		}})
+		return nil
+	}
+
+	after := *live
+	if spec.Role != "" {
+		after.Role = spec.Role
+	}
+	if spec.Teams != nil {
+		after.Teams = spec.Teams
+	}
+	id := live.ID.String()
+	p.add(step{action: actionUpdate, kind: m.Kind, name: m.path(), changes: common.Diff(memberFields, *live, after), run: func(ctx context.Context) error {
+		// The role and teams are replaced, so send the ones the member keeps
+		_, err := p.client.UpdateMember(ctx, org, id, glitchtip.MemberUpdateRequest{OrgRole: after.Role, TeamRoles: teamRoles(after.Teams)})
+		return err
+	}})
+	return nil
+}
+
+// orgMembers returns the members of an organization, none for
+// organizations that are created by the plan
+func (p *planner) orgMembers(ctx context.Context, org string) ([]glitchtip.Member, error) {
+	if p.newOrgs[org] {
+		return nil, nil
+	}
+	if members, ok := p.members[org]; ok {
+		return members, nil
+	}
+	members, err := p.client.ListMembers(ctx, org, nil)
+	if err != nil {
+		return nil, err
+	}
+	p.members[org] = members
+	return members, nil
+}
+
+// teamRoles adds a member to each of the teams
+func teamRoles(teams []string) []glitchtip.TeamRole {
+	roles := make([]glitchtip.TeamRole, len(teams))
+	for i, team := range teams {
+		roles[i] = glitchtip.TeamRole{TeamSlug: team}
+	}
+	return roles
+}
+
+// alertFields are the settings of an alert rule that apply compares
+var alertFields = []common.Column[glitchtip.ProjectAlert]{
+	{Header: "condition", Value: alert.Condition},
+	{Header: "recipients", Value: func(rule glitchtip.ProjectAlert) string {
+		recipients := strings.Split(alert.Recipients(rule), ", ")
+		return strings.Join(sorted(recipients), ", ")
+	}},
+}
+
+// alertRequest returns the payload that creates or replaces an alert rule
+func alertRequest(name string, spec *alertSpec) glitchtip.ProjectAlertRequest {
+	payload := glitchtip.ProjectAlertRequest{Name: name, Uptime: spec.Uptime}
+	if !spec.Uptime {
+		payload.TimespanMinutes, payload.Quantity = spec.TimespanMinutes, spec.Quantity
+	}
+	for _, recipient := range spec.Recipients {
+		payload.AlertRecipients = append(payload.AlertRecipients, glitchtip.AlertRecipient{RecipientType: recipient.Type, URL: recipient.URL})
+	}
+	return payload
+}
+
+func (p *planner) planAlert(ctx context.Context, m *manifest, spec *alertSpec) error {
+	org, project, name := m.Metadata.Organization, m.Metadata.Project, m.Metadata.Name
+	payload := alertRequest(name, spec)
+	create := step{action: actionCreate, kind: m.Kind, name: m.path(), run: func(ctx context.Context) error {
+		_, err := p.client.CreateProjectAlert(ctx, org, project, payload)
+		return err
+	}}
+	if p.newOrgs[org] || p.newProjects[org+"/"+project] {
+		p.add(create)
+		return nil
+	}
+	live, err := p.client.FindProjectAlert(ctx, org, project, name)
+	if glitchtip.IsNotFound(err) {
+		p.add(create)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	after := *live
+	after.TimespanMinutes, after.Quantity, after.Uptime = payload.TimespanMinutes, payload.Quantity, payload.Uptime
+	after.AlertRecipients = payload.AlertRecipients
+	id := live.ID.String()
+	p.add(step{action: actionUpdate, kind: m.Kind, name: m.path(), changes: common.Diff(alertFields, *live, after), run: func(ctx context.Context) error {
+		_, err := p.client.UpdateProjectAlert(ctx, org, project, id, payload)
+		return err
+	}})
+	return nil
+}
+
+// monitorFields are the settings of a monitor that apply compares
+var monitorFields = []common.Column[glitchtip.Monitor]{
+	{Header: "type", Value: func(monitor glitchtip.Monitor) string { return monitor.MonitorType }},
+	{Header: "url", Value: func(monitor glitchtip.Monitor) string { return monitor.URL }},
+	{Header: "keyword", Value: func(monitor glitchtip.Monitor) string { return monitor.ExpectedBody }},
+	{Header: "interval", Value: func(monitor glitchtip.Monitor) string { return strconv.Itoa(monitor.Interval) }},
+	{Header: "timeout", Value: func(monitor glitchtip.Monitor) string { return optionalInt(monitor.Timeout) }},
+	{Header: "expectedStatus", Value: func(monitor glitchtip.Monitor) string { return optionalInt(monitor.ExpectedStatus) }},
+	{Header: "project", Value: func(monitor glitchtip.Monitor) string { return monitor.Project.String() }},
+	{Header: "paused", Value: func(monitor glitchtip.Monitor) string { return strconv.FormatBool(monitor.IsPaused) }},
+}
+
+// optionalInt renders a number that may be unset
+func optionalInt(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
+
+// monitorRequest returns the payload that creates or replaces a monitor,
+// without the ID of its project
+func monitorRequest(name string, spec *monitorSpec) glitchtip.MonitorRequest {
+	payload := glitchtip.MonitorRequest{
+		Name:           name,
+		MonitorType:    monitorTypes[spec.Type],
+		URL:            spec.URL,
+		ExpectedStatus: spec.ExpectedStatus,
+		ExpectedBody:   spec.Keyword,
+		Interval:       60,
+	}
+	if spec.Interval != nil {
+		payload.Interval = int(*spec.Interval)
+	}
+	if spec.Paused != nil {
+		payload.IsPaused = *spec.Paused
+	}
+	if spec.Timeout != 0 {
+		timeout := int(spec.Timeout)
+		payload.Timeout = &timeout
+	}
+	if spec.Type == "heartbeat" {
+		payload.URL, payload.ExpectedStatus = "", nil
+	}
+	return payload
+}
+
+func (p *planner) planMonitor(ctx context.Context, m *manifest, spec *monitorSpec) error {
+	org, name := m.Metadata.Organization, m.Metadata.Name
+	payload := monitorRequest(name, spec)
+	// The project may be created by the plan, so look its ID up when the
+	// step runs
+	run := func(update func(ctx context.Context, payload glitchtip.MonitorRequest) error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			if spec.Project != "" {
+				project, err := p.client.GetProject(ctx, org, spec.Project)
+				if err != nil {
+					return err
+				}
+				payload.Project = project.ID
+			}
+			return update(ctx, payload)
+		}
+	}
+	create := step{action: actionCreate, kind: m.Kind, name: m.path(), run: run(func(ctx context.Context, payload glitchtip.MonitorRequest) error {
+		_, err := p.client.CreateMonitor(ctx, org, payload)
+		return err
+	})}
+
+	monitors, err := p.orgMonitors(ctx, org)
+	if err != nil {
+		return err
+	}
+	var live *glitchtip.Monitor
+	for i := range monitors {
+		if monitors[i].Name == name {
+			live = &monitors[i]
+		}
+	}
+	if live == nil {
+		p.add(create)
+		return nil
+	}
+
+	// Settings the manifest leaves out keep their current values
+	if spec.Timeout == 0 {
+		payload.Timeout = live.Timeout
+	}
+	if spec.ExpectedStatus == nil && spec.Type != "heartbeat" {
+		payload.ExpectedStatus = live.ExpectedStatus
+	}
+	if spec.Interval == nil {
+		payload.Interval = live.Interval
+	}
+	if spec.Paused == nil {
+		payload.IsPaused = live.IsPaused
+	}
+	if spec.Project == "" {
+		payload.Project = live.Project
+	}
+
+	after := *live
+	after.MonitorType, after.URL, after.ExpectedBody = payload.MonitorType, payload.URL, payload.ExpectedBody
+	after.Interval, after.Timeout, after.ExpectedStatus, after.IsPaused = payload.Interval, payload.Timeout, payload.ExpectedStatus, payload.IsPaused
+	after.Project = payload.Project
+	if spec.Project != "" {
+		// A project that does not exist yet shows up by slug
+		after.Project = glitchtip.ID(spec.Project)
+		if project := p.projects[org+"/"+spec.Project]; project != nil {
+			after.Project = project.ID
+		} else if !p.newProjects[org+"/"+spec.Project] {
+			project, err := p.client.GetProject(ctx, org, spec.Project)
+			if err != nil {
+				return err
+			}
+			after.Project = project.ID
+		}
+	}
+	id := live.ID.String()
+	p.add(step{action: actionUpdate, kind: m.Kind, name: m.path(), changes: common.Diff(monitorFields, *live, after), run: run(func(ctx context.Context, payload glitchtip.MonitorRequest) error {
+		_, err := p.client.UpdateMonitor(ctx, org, id, payload)
+		return err
+	})})
+	return nil
+}
+
+// orgMonitors returns the monitors of an organization, none for
+// organizations that are created by the plan
+func (p *planner) orgMonitors(ctx context.Context, org string) ([]glitchtip.Monitor, error) {
+	if p.newOrgs[org] {
+		return nil, nil
+	}
+	if monitors, ok := p.monitors[org]; ok {
+		return monitors, nil
+	}
+	monitors, err := p.client.ListMonitors(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	p.monitors[org] = monitors
+	return monitors, nil
+}
+
+// projectTeams returns the slugs of the teams a project belongs to
+func projectTeams(project *glitchtip.Project) []string {
+	slugs := make([]string, len(project.Teams))
+	for i, team := range project.Teams {
+		slugs[i] = team.Slug
+	}
+	return slugs
+}
+
+// sorted returns a sorted copy of values
+func sorted(values []string) []string {
+	values = append([]string(nil), values...)
+	sort.Strings(values)
+	return values
+}
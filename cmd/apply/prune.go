@@ -0,0 +1,151 @@
+package apply
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/cmd/alert"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// prune plans the deletion of the resources in the scopes picked by the
+// selector that no manifest declares. Organizations that match are scopes
+// for their teams, projects, members and monitors; projects that match, or
+// whose organization matches, for their alerts and for their keys if the
+// project lists keys. The member of the logged in user is never deleted.
+func (p *planner) prune(ctx context.Context, selector map[string]string, self string) error {
+	declared := map[string]bool{}
+	orgScopes := map[string]bool{}
+	for _, m := range p.manifests {
+		declared[declaredKey(m.Kind, m.path())] = true
+		if project, ok := m.spec.(*projectSpec); ok {
+			for _, key := range project.Keys {
+				declared[declaredKey(kindKey, m.path()+"/"+key.Name)] = true
+			}
+		}
+		if m.Kind == kindOrganization && m.matches(selector) && !p.newOrgs[m.Metadata.Name] {
+			orgScopes[m.Metadata.Name] = true
+		}
+	}
+
+	var deletions [][]step
+	addDeletion := func(order int, kind, name string, del func(ctx context.Context) error) {
+		for len(deletions) <= order {
+			deletions = append(deletions, nil)
+		}
+		if !declared[declaredKey(kind, name)] {
+			deletions[order] = append(deletions[order], step{action: actionDelete, kind: kind, name: name, run: del})
+		}
+	}
+
+	// Resources are deleted before the ones they belong to
+	const (
+		orderMonitor = iota
+		orderAlert
+		orderKey
+		orderMember
+		orderProject
+		orderTeam
+	)
+
+	for _, m := range p.manifests {
+		org := m.Metadata.Organization
+		if m.Kind == kindOrganization && orgScopes[org] {
+			teams, err := p.client.ListOrganizationTeams(ctx, org, nil)
+			if err != nil {
+				return err
+			}
+			for _, team := range teams {
+				slug := team.Slug
+				addDeletion(orderTeam, kindTeam, org+"/"+slug, func(ctx context.Context) error {
+					return p.client.DeleteTeam(ctx, org, slug)
+				})
+			}
+			projects, err := p.client.ListOrganizationProjects(ctx, org, nil)
+			if err != nil {
+				return err
+			}
+			for _, project := range projects {
+				slug := project.Slug
+				addDeletion(orderProject, kindProject, org+"/"+slug, func(ctx context.Context) error {
+					return p.client.DeleteProject(ctx, org, slug)
+				})
+			}
+			members, err := p.orgMembers(ctx, org)
+			if err != nil {
+				return err
+			}
+			for _, member := range members {
+				if strings.EqualFold(member.Email, self) {
+					continue
+				}
+				id := member.ID.String()
+				addDeletion(orderMember, kindMember, org+"/"+member.Email, func(ctx context.Context) error {
+					return p.client.DeleteMember(ctx, org, id)
+				})
+			}
+			monitors, err := p.orgMonitors(ctx, org)
+			if err != nil {
+				return err
+			}
+			for _, monitor := range monitors {
+				id := monitor.ID.String()
+				addDeletion(orderMonitor, kindMonitor, org+"/"+monitor.Name, func(ctx context.Context) error {
+					return p.client.DeleteMonitor(ctx, org, id)
+				})
+			}
+		}
+
+		spec, ok := m.spec.(*projectSpec)
+		if !ok || !(orgScopes[org] || m.matches(selector)) || p.newOrgs[org] || p.newProjects[m.path()] {
+			continue
+		}
+		slug := m.Metadata.Name
+		alerts, err := p.client.ListProjectAlerts(ctx, org, slug)
+		if err != nil {
+			return err
+		}
+		for _, rule := range alerts {
+			id := rule.ID.String()
+			addDeletion(orderAlert, kindAlert, m.path()+"/"+alert.DisplayName(rule), func(ctx context.Context) error {
+				return p.client.DeleteProjectAlert(ctx, org, slug, id)
+			})
+		}
+		if spec.Keys == nil {
+			continue
+		}
+		keys, err := p.client.ListProjectKeys(ctx, org, slug)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			id := key.ID.String()
+			addDeletion(orderKey, kindKey, m.path()+"/"+key.Name, func(ctx context.Context) error {
+				return p.client.DeleteProjectKey(ctx, org, slug, id)
+			})
+		}
+	}
+
+	for _, steps := range deletions {
+		p.plan.steps = append(p.plan.steps, steps...)
+	}
+	return nil
+}
+
+// declaredKey identifies a resource among the declared ones. Members are
+// matched by email regardless of case, as when planning them.
+func declaredKey(kind, name string) string {
+	if kind == kindMember {
+		name = strings.ToLower(name)
+	}
+	return kind + " " + name
+}
+
+// currentEmail returns the email of the logged in user
+func currentEmail(ctx context.Context, client *glitchtip.Client) (string, error) {
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.Email, nil
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// ConfigCmd groups the commands that manage named contexts
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage glitchtipctl contexts",
+	Long: `Manage the named contexts stored in ~/.config/glitchtipctl/config.yaml.
+
+Each context holds the URL of a GlitchTip server, an API token and a default
+organization. The current context is used by every command unless the global
+--context flag selects another one.
+
+Example usage:
+  glitchtipctl config set-context staging --url https://glitchtip.staging.example.com --organization acme
+  glitchtipctl config use-context staging
+  glitchtipctl config get-contexts`,
+}
+
+var (
+	contextURL          string
+	contextToken        string
+	contextOrganization string
+	contextTokenStdin   bool
+)
+
+// setContextCmd creates or updates a context
+var setContextCmd = &cobra.Command{
+	Use:   "set-context <name>",
+	Short: "Create or update a context",
+	Long: `Create a context, or update the fields of an existing one. Only the flags that
+are given are changed on an existing context.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := common.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx := common.Context{Name: args[0]}
+		if existing := config.Context(args[0]); existing != nil {
+			ctx = *existing
+		}
+		if cmd.Flags().Changed("url") {
+			ctx.URL = contextURL
+		}
+		if cmd.Flags().Changed("organization") {
+			ctx.Organization = contextOrganization
+		}
+
+		token := contextToken
+		if contextTokenStdin {
+			if token, err = common.ReadTokenFromStdin(); err != nil {
+				return err
+			}
+		}
+		if token != "" {
+			store, err := common.StoreToken(ctx.Name, token)
+			if err != nil {
+				return err
+			}
+			ctx.Token = ""
+			common.Infof("Token stored in %s.", store)
+		}
+
+		config.SetContext(ctx)
+		if config.CurrentContext == "" {
+			config.CurrentContext = ctx.Name
+		}
+		if err := config.Save(); err != nil {
+			return err
+		}
+		common.Infof("Context %q saved.", ctx.Name)
+		return nil
+	},
+}
+
+// useContextCmd switches the current context
+var useContextCmd = &cobra.Command{
+	Use:   "use-context <name>",
+	Short: "Set the current context",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := common.LoadConfig()
+		if err != nil {
+			return err
+		}
+		if config.Context(args[0]) == nil {
+			return common.Validationf("context %q does not exist", args[0])
+		}
+
+		config.CurrentContext = args[0]
+		if err := config.Save(); err != nil {
+			return err
+		}
+		common.Infof("Switched to context %q.", args[0])
+		return nil
+	},
+}
+
+// getContextsCmd lists the configured contexts
+var getContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "List the configured contexts",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := common.OutputFormat(cmd)
+		if err != nil {
+			return err
+		}
+		config, err := common.LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		printer := common.ResourcePrinter[common.Context]{
+			Kind: "context",
+			Name: func(ctx common.Context) string { return ctx.Name },
+			Columns: []common.Column[common.Context]{
+				{Header: "Current", Value: func(ctx common.Context) string {
+					if ctx.Name == config.ActiveContextName() {
+						return "*"
+					}
+					return ""
+				}},
+				{Header: "Name", Value: func(ctx common.Context) string { return ctx.Name }},
+				{Header: "URL", Value: func(ctx common.Context) string { return ctx.URL }},
+				{Header: "Organization", Value: func(ctx common.Context) string { return ctx.Organization }},
+			},
+		}
+		contexts := config.Contexts
+		if contexts == nil {
+			contexts = []common.Context{}
+		}
+		return printer.PrintList(os.Stdout, format, contexts)
+	},
+}
+
+// currentContextCmd prints the name of the current context
+var currentContextCmd = &cobra.Command{
+	Use:   "current-context",
+	Short: "Print the name of the current context",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := common.LoadConfig()
+		if err != nil {
+			return err
+		}
+		if config.CurrentContext == "" {
+			return common.Validationf("current context is not set")
+		}
+		fmt.Println(config.CurrentContext)
+		return nil
+	},
+}
+
+// deleteContextCmd removes a context
+var deleteContextCmd = &cobra.Command{
+	Use:   "delete-context <name>",
+	Short: "Delete a context",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := common.LoadConfig()
+		if err != nil {
+			return err
+		}
+		if !config.DeleteContext(args[0]) {
+			return common.Validationf("context %q does not exist", args[0])
+		}
+		if err := common.DeleteToken(args[0]); err != nil {
+			return err
+		}
+		if err := config.Save(); err != nil {
+			return err
+		}
+		common.Infof("Context %q deleted.", args[0])
+		return nil
+	},
+}
+
+func init() {
+	setContextCmd.Flags().StringVar(&contextURL, "url", "", "URL of the GlitchTip server, e.g. https://glitchtip.example.com")
+	setContextCmd.Flags().StringVar(&contextToken, "token", "", "API token used to authenticate")
+	setContextCmd.Flags().BoolVar(&contextTokenStdin, "token-stdin", false, "Read the API token from stdin")
+	setContextCmd.Flags().StringVar(&contextOrganization, "organization", "", "Default organization slug")
+
+	common.AddOutputFlag(getContextsCmd)
+
+	ConfigCmd.AddCommand(setContextCmd)
+	ConfigCmd.AddCommand(useContextCmd)
+	ConfigCmd.AddCommand(getContextsCmd)
+	ConfigCmd.AddCommand(currentContextCmd)
+	ConfigCmd.AddCommand(deleteContextCmd)
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// EventsCmd groups the commands that inspect events
+var EventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Inspect the events of an issue",
+	Long: `Inspect the events of an issue: list them, or show one with its exception chain, stack frames,
+breadcrumbs, request data, tags and contexts.`,
+}
+
+// eventPrinter prints events in every output format
+var eventPrinter = common.ResourcePrinter[glitchtip.Event]{
+	Kind: "event",
+	Name: func(event glitchtip.Event) string { return event.EventID },
+	Columns: []common.Column[glitchtip.Event]{
+		{Header: "Event ID", Value: func(event glitchtip.Event) string { return event.EventID }},
+		{Header: "Received", Value: func(event glitchtip.Event) string {
+			return firstNonEmpty(event.DateReceived, event.DateCreated)
+		}},
+		{Header: "Environment", Wide: true, Value: func(event glitchtip.Event) string {
+			environment, _ := event.Tag("environment")
+			return environment
+		}},
+		{Header: "Release", Wide: true, Value: func(event glitchtip.Event) string {
+			release, _ := event.Tag("release")
+			return release
+		}},
+		{Header: "Title", Value: func(event glitchtip.Event) string { return event.Title }},
+	},
+}
+
+func init() {
+	EventsCmd.AddCommand(newListCmd())
+	EventsCmd.AddCommand(ShowEventCmd)
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the "get events" command, the event list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "events <issue_id>"
+	cmd.Aliases = []string{"event"}
+	return cmd
+}
+
+// newListCmd creates the events list command
+func newListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <issue_id>",
+		Short: "List the events of an issue, newest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			events, err := common.FetchWithSpinner("Fetching events...", func() ([]glitchtip.Event, error) {
+				return client.ListIssueEvents(context.Background(), args[0], opts)
+			})
+			if err != nil {
+				return err
+			}
+			return eventPrinter.PrintList(os.Stdout, format, events)
+		},
+	}
+
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
+}
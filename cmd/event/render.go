@@ -0,0 +1,316 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// Styles used by the event view. lipgloss drops the colors when stdout is
+// not a terminal or NO_COLOR is set.
+var (
+	headingStyle = lipgloss.NewStyle().Bold(true).Underline(true)
+	titleStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("1"))
+	labelStyle   = lipgloss.NewStyle().Faint(true)
+	inAppStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3"))
+	systemStyle  = lipgloss.NewStyle().Faint(true)
+	currentStyle = lipgloss.NewStyle().Bold(true)
+)
+
+// RenderOptions controls how much of an event is shown
+type RenderOptions struct {
+	// AllFrames shows source context for library frames as well
+	AllFrames bool
+	// Breadcrumbs is the number of most recent breadcrumbs to show
+	Breadcrumbs int
+}
+
+// RenderEvent writes a readable view of an event: the exception chain
+// with stack frames, breadcrumbs, request data, tags and contexts.
+func RenderEvent(w io.Writer, event *glitchtip.Event, opts RenderOptions) error {
+	r := &eventRenderer{w: w, opts: opts}
+
+	r.line(titleStyle.Render(event.Title))
+	r.field("Event", event.EventID)
+	r.field("Issue", event.GroupID.String())
+	r.field("Received", firstNonEmpty(event.DateReceived, event.DateCreated))
+	r.field("Platform", event.Platform)
+	r.field("Culprit", event.Culprit)
+
+	message, err := event.MessageEntry()
+	if err != nil {
+		return err
+	}
+	if message != nil {
+		r.section("Message")
+		r.line("  " + firstNonEmpty(message.Formatted, message.Message))
+	}
+
+	exception, err := event.Exception()
+	if err != nil {
+		return err
+	}
+	if exception != nil {
+		r.exceptions(exception.Values)
+	}
+
+	breadcrumbs, err := event.Breadcrumbs()
+	if err != nil {
+		return err
+	}
+	if breadcrumbs != nil {
+		r.breadcrumbs(breadcrumbs.Values)
+	}
+
+	request, err := event.Request()
+	if err != nil {
+		return err
+	}
+	if request != nil {
+		r.request(request)
+	}
+
+	if len(event.Tags) > 0 {
+		r.section("Tags")
+		for _, tag := range event.Tags {
+			r.line(fmt.Sprintf("  %s %s", labelStyle.Render(tag.Key+":"), tag.Value))
+		}
+	}
+	if len(event.User) > 0 {
+		r.section("User")
+		r.line("  " + formatMap(event.User))
+	}
+	if len(event.Contexts) > 0 {
+		r.section("Contexts")
+		for _, name := range sortedKeys(event.Contexts) {
+			value := event.Contexts[name]
+			if values, ok := value.(map[string]interface{}); ok {
+				r.line(fmt.Sprintf("  %s %s", labelStyle.Render(name+":"), formatMap(values)))
+			} else {
+				r.line(fmt.Sprintf("  %s %s", labelStyle.Render(name+":"), formatValue(value)))
+			}
+		}
+	}
+	return r.err
+}
+
+// eventRenderer writes lines and remembers the first write error
+type eventRenderer struct {
+	w    io.Writer
+	opts RenderOptions
+	err  error
+}
+
+func (r *eventRenderer) line(text string) {
+	if r.err == nil {
+		_, r.err = fmt.Fprintln(r.w, text)
+	}
+}
+
+func (r *eventRenderer) field(label, value string) {
+	if value != "" {
+		r.line(fmt.Sprintf("%s %s", labelStyle.Render(fmt.Sprintf("%-9s", label+":")), value))
+	}
+}
+
+func (r *eventRenderer) section(title string) {
+	r.line("")
+	r.line(headingStyle.Render(title))
+}
+
+// exceptions renders the chain with the exception that was raised first
+// and its causes after it, each stack trace with the most recent call
+// first
+func (r *eventRenderer) exceptions(values []glitchtip.Exception) {
+	r.section("Exception")
+	for i := len(values) - 1; i >= 0; i-- {
+		exception := values[i]
+		if i < len(values)-1 {
+			r.line("")
+			r.line(labelStyle.Render("Caused by:"))
+		}
+
+		heading := exception.Type
+		if exception.Value != "" {
+			heading += ": " + exception.Value
+		}
+		if mechanism := exception.Mechanism; mechanism != nil {
+			details := []string{}
+			if mechanism.Type != "" {
+				details = append(details, "mechanism: "+mechanism.Type)
+			}
+			if mechanism.Handled != nil && !*mechanism.Handled {
+				details = append(details, "unhandled")
+			}
+			if len(details) > 0 {
+				heading += " " + labelStyle.Render("("+strings.Join(details, ", ")+")")
+			}
+		}
+		r.line("  " + titleStyle.Render(heading))
+
+		if exception.Stacktrace == nil {
+			continue
+		}
+		frames := exception.Stacktrace.Frames
+		for j := len(frames) - 1; j >= 0; j-- {
+			r.frame(frames[j])
+		}
+	}
+}
+
+func (r *eventRenderer) frame(frame glitchtip.Frame) {
+	location := firstNonEmpty(frame.Filename, frame.AbsPath, frame.Module, frame.Package, "?")
+	if frame.LineNo > 0 {
+		location += fmt.Sprintf(":%d", frame.LineNo)
+		if frame.ColNo > 0 {
+			location += fmt.Sprintf(":%d", frame.ColNo)
+		}
+	}
+	text := fmt.Sprintf("    at %s (%s)", firstNonEmpty(frame.Function, "?"), location)
+	if !frame.InApp {
+		r.line(systemStyle.Render(text))
+		if !r.opts.AllFrames {
+			return
+		}
+	} else {
+		r.line(inAppStyle.Render(text))
+	}
+
+	for _, context := range frame.Context {
+		code := fmt.Sprintf("%6d | %s", context.LineNo, strings.TrimRight(context.Code, " \t\r\n"))
+		if context.LineNo == frame.LineNo {
+			r.line("    >" + currentStyle.Render(code[1:]))
+		} else {
+			r.line("     " + labelStyle.Render(code[1:]))
+		}
+	}
+	for _, name := range sortedKeys(frame.Vars) {
+		r.line(fmt.Sprintf("        %s %s", labelStyle.Render(name+" ="), formatValue(frame.Vars[name])))
+	}
+}
+
+// breadcrumbs renders the most recent breadcrumbs, oldest first
+func (r *eventRenderer) breadcrumbs(values []glitchtip.Breadcrumb) {
+	if len(values) == 0 || r.opts.Breadcrumbs == 0 {
+		return
+	}
+	shown := values
+	title := "Breadcrumbs"
+	if r.opts.Breadcrumbs > 0 && len(values) > r.opts.Breadcrumbs {
+		shown = values[len(values)-r.opts.Breadcrumbs:]
+		title = fmt.Sprintf("Breadcrumbs (last %d of %d)", len(shown), len(values))
+	}
+	r.section(title)
+	for _, crumb := range shown {
+		message := crumb.Message
+		if message == "" && len(crumb.Data) > 0 {
+			message = formatMap(crumb.Data)
+		}
+		r.line(fmt.Sprintf("  %s %-12s %-8s %s",
+			labelStyle.Render(formatTimestamp(crumb.Timestamp)),
+			firstNonEmpty(crumb.Category, crumb.Type, "default"),
+			firstNonEmpty(crumb.Level, "info"),
+			message))
+	}
+}
+
+func (r *eventRenderer) request(request *glitchtip.RequestEntry) {
+	r.section("Request")
+	r.line("  " + strings.TrimSpace(request.Method+" "+request.URL))
+	if query := formatQuery(request.Query); query != "" {
+		r.line(fmt.Sprintf("  %s %s", labelStyle.Render("Query:"), query))
+	}
+	if request.Data != nil {
+		r.line(fmt.Sprintf("  %s %s", labelStyle.Render("Body:"), formatValue(request.Data)))
+	}
+	if len(request.Headers) > 0 {
+		r.line("  " + labelStyle.Render("Headers:"))
+		for _, header := range request.Headers {
+			if len(header) == 2 {
+				r.line(fmt.Sprintf("    %s %s", labelStyle.Render(header[0]+":"), header[1]))
+			}
+		}
+	}
+}
+
+// formatTimestamp formats an ISO 8601 or Unix timestamp
+func formatTimestamp(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		seconds, fraction := math.Modf(v)
+		return time.Unix(int64(seconds), int64(fraction*1e9)).UTC().Format(time.RFC3339)
+	case nil:
+		return ""
+	}
+	return formatValue(value)
+}
+
+// formatQuery formats a query string given as a string or as pairs
+func formatQuery(query interface{}) string {
+	switch v := query.(type) {
+	case string:
+		return v
+	case []interface{}:
+		pairs := make([]string, 0, len(v))
+		for _, pair := range v {
+			if kv, ok := pair.([]interface{}); ok && len(kv) == 2 {
+				pairs = append(pairs, formatValue(kv[0])+"="+formatValue(kv[1]))
+			}
+		}
+		return strings.Join(pairs, "&")
+	case nil:
+		return ""
+	}
+	return formatValue(query)
+}
+
+// formatMap formats a map as sorted key=value pairs
+func formatMap(values map[string]interface{}) string {
+	pairs := make([]string, 0, len(values))
+	for _, key := range sortedKeys(values) {
+		pairs = append(pairs, key+"="+formatValue(values[key]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// formatValue formats a decoded JSON value on one line
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return "null"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
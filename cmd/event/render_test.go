@@ -0,0 +1,65 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func renderTestEvent(t *testing.T, opts RenderOptions) string {
+	t.Helper()
+	data, err := os.ReadFile("../../pkg/glitchtip/testdata/event.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var event glitchtip.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := RenderEvent(&out, &event, opts); err != nil {
+		t.Fatalf("RenderEvent: %v", err)
+	}
+	return out.String()
+}
+
+func TestRenderEvent(t *testing.T) {
+	out := renderTestEvent(t, RenderOptions{Breadcrumbs: 1})
+
+	// The raised exception comes first, then its cause
+	raised := strings.Index(out, "ValueError: invalid literal")
+	cause := strings.Index(out, "KeyError: 'qty'")
+	if raised < 0 || cause < 0 || cause < raised || !strings.Contains(out, "Caused by:") {
+		t.Errorf("exception chain out of order:\n%s", out)
+	}
+	for _, want := range []string{
+		"(mechanism: django, unhandled)",
+		"at checkout (shop/views.py:42)",
+		">   42 |     total = int(qty) * price",
+		"qty = 'abc'",
+		"at _get_response (django/core/handlers/base.py:197)",
+		"Breadcrumbs (last 1 of 2)",
+		"2024-05-14T09:59:59Z",
+		"method=GET status_code=200",
+		"POST https://shop.example.com/checkout",
+		"Query: step=2",
+		"User-Agent: Firefox/125.0",
+		"release: 1.4.0",
+		"runtime: name=CPython version=3.12.2",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+	// The most recent call comes first
+	if strings.Index(out, "at checkout") > strings.Index(out, "at _get_response") {
+		t.Errorf("frames are not in most recent call first order:\n%s", out)
+	}
+	if strings.Contains(out, "SELECT * FROM cart") {
+		t.Errorf("older breadcrumbs should be cut off:\n%s", out)
+	}
+}
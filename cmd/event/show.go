@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+var (
+	showIssue       string
+	showOrg         string
+	showProject     string
+	showBreadcrumbs int
+)
+
+// ShowEventCmd represents the events show command
+var ShowEventCmd = &cobra.Command{
+	Use:   "show [event_id|latest]",
+	Short: "Show an event with its stack trace, breadcrumbs and request",
+	Long: `Show an event with its exception chain, stack frames, breadcrumbs, request data, tags and contexts.
+
+The event is looked up in the issue given with --issue, or in the project given with --project.
+Without an event ID, or with "latest", the most recent event of the issue is shown.
+
+Frames of your own code (in-app frames) are highlighted and show their source context; library
+frames are shown on one line. -o wide shows source context for every frame, and -o json prints the
+event exactly as returned by the API, for piping into jq.`,
+	Example: `  glitchtipctl events show --issue 1042
+  glitchtipctl events show 8c1f9f4e2b7d4a3c9e0f1a2b3c4d5e6f --project web
+  glitchtipctl events show --issue 1042 -o json | jq '.entries[] | select(.type == "exception")'`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := common.OutputFormat(cmd)
+		if err != nil {
+			return err
+		}
+		eventID := "latest"
+		if len(args) > 0 {
+			eventID = args[0]
+		}
+		if showIssue == "" && showProject == "" {
+			return common.Validationf("either --issue or --project is required")
+		}
+		if showIssue == "" && eventID == "latest" {
+			return common.Validationf("the latest event can only be shown for an issue, pass --issue")
+		}
+
+		var orgSlug string
+		if showIssue == "" {
+			if orgSlug, err = common.ResolveOrganization(showOrg); err != nil {
+				return err
+			}
+		}
+		client, err := common.NewClient()
+		if err != nil {
+			return err
+		}
+
+		event, err := common.FetchWithSpinner("Fetching event...", func() (*glitchtip.Event, error) {
+			ctx := context.Background()
+			switch {
+			case showIssue != "" && eventID == "latest":
+				return client.GetLatestIssueEvent(ctx, showIssue)
+			case showIssue != "":
+				return client.GetIssueEvent(ctx, showIssue, eventID)
+			default:
+				return client.GetProjectEvent(ctx, orgSlug, showProject, eventID)
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		switch format {
+		case "table", "wide":
+			return RenderEvent(os.Stdout, event, RenderOptions{
+				AllFrames:   format == "wide",
+				Breadcrumbs: showBreadcrumbs,
+			})
+		default:
+			return eventPrinter.PrintObject(os.Stdout, format, *event)
+		}
+	},
+}
+
+func init() {
+	ShowEventCmd.Flags().StringVar(&showIssue, "issue", "", "ID of the issue the event belongs to")
+	ShowEventCmd.Flags().StringVar(&showOrg, "org", "", "Organization slug used with --project (defaults to the context organization)")
+	ShowEventCmd.Flags().StringVarP(&showProject, "project", "p", "", "Slug of the project the event belongs to")
+	ShowEventCmd.Flags().IntVar(&showBreadcrumbs, "breadcrumbs", 20, "Number of most recent breadcrumbs to show (-1 shows all)")
+	common.AddOutputFlag(ShowEventCmd)
+}
@@ -0,0 +1,285 @@
+package heartbeat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// maxStderr is how much of the end of a failed job's stderr is reported
+const maxStderr = 16 << 10
+
+var (
+	heartbeatOrg           string
+	heartbeatDSN           string
+	heartbeatEnvironment   string
+	heartbeatReportFailure bool
+)
+
+// HeartbeatCmd runs a job and pings its heartbeat monitor when it succeeds
+var HeartbeatCmd = &cobra.Command{
+	Use:   "heartbeat <monitor> -- <command> [args...]",
+	Short: "Run a job and ping its heartbeat monitor when it succeeds",
+	Long: `Run a job, such as a cron job, and ping its heartbeat monitor when it exits with code 0. The
+monitor goes down when no ping arrives within its interval.
+
+The monitor is given by its heartbeat URL, which needs no API token, or by ID or name, which is
+looked up with the active context after the job has run.
+
+With --report-failure a failed job is also reported as an error event carrying the end of its
+stderr. The event is sent with --dsn, $GLITCHTIP_DSN or $SENTRY_DSN, or else with the first active
+client key of the monitor's project.
+
+glitchtipctl exits with the exit code of the job.`,
+	Example: `  glitchtipctl heartbeat "Nightly backup" -- /usr/local/bin/backup --full
+  glitchtipctl heartbeat https://glitchtip.example.com/api/0/organizations/acme/heartbeat_check/4b1e.../ -- ./sync.sh
+
+  # crontab
+  0 3 * * * glitchtipctl heartbeat "Nightly backup" --report-failure -- /usr/local/bin/backup`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Flags after -- belong to the job
+		if cmd.ArgsLenAtDash() != 1 {
+			return common.Validationf("give the monitor, then -- and the job to run, e.g. heartbeat backup -- ./backup.sh")
+		}
+		return runHeartbeat(context.Background(), args[0], args[1:])
+	},
+}
+
+// target is the monitor a heartbeat is sent to
+type target struct {
+	client  *glitchtip.Client
+	orgSlug string
+	// monitor is nil when the monitor was given by URL
+	monitor *glitchtip.Monitor
+	url     string
+}
+
+// name returns how the monitor is referred to in messages
+func (t *target) name() string {
+	if t.monitor != nil {
+		return t.monitor.Name
+	}
+	return t.url
+}
+
+// runHeartbeat runs the job and pings the monitor or reports the failure
+func runHeartbeat(ctx context.Context, monitorArg string, command []string) error {
+	dsn, err := failureDSN(monitorArg)
+	if err != nil {
+		return err
+	}
+
+	stderr := &tailBuffer{limit: maxStderr}
+	job := exec.CommandContext(ctx, command[0], command[1:]...)
+	job.Stdin, job.Stdout, job.Stderr = os.Stdin, os.Stdout, io.MultiWriter(os.Stderr, stderr)
+	start := time.Now()
+	runErr := job.Run()
+	duration := time.Since(start)
+
+	if runErr == nil {
+		t, err := resolveTarget(ctx, monitorArg)
+		if err != nil {
+			return fmt.Errorf("the job succeeded, but no heartbeat was sent: %w", err)
+		}
+		if err := t.client.SendHeartbeat(ctx, t.url); err != nil {
+			return fmt.Errorf("the job succeeded, but no heartbeat was sent: %w", err)
+		}
+		common.Infof("Job succeeded in %s, heartbeat sent to monitor %s", duration.Round(time.Millisecond), t.name())
+		return nil
+	}
+
+	jobErr := &common.ExitStatusError{Code: exitCode(runErr), Err: fmt.Errorf("%s failed: %w", command[0], runErr)}
+	if !heartbeatReportFailure {
+		return jobErr
+	}
+	eventID, err := reportFailure(ctx, monitorArg, dsn, command, jobErr, duration, stderr.String())
+	if err != nil {
+		common.Infof("The failure could not be reported: %v", err)
+		return jobErr
+	}
+	common.Infof("Reported the failure as event %s", eventID)
+	return jobErr
+}
+
+// failureDSN returns the DSN failures are reported with, or nil when it
+// is looked up from the monitor's project
+func failureDSN(monitorArg string) (*glitchtip.DSN, error) {
+	if !heartbeatReportFailure {
+		return nil, nil
+	}
+	value := heartbeatDSN
+	for _, name := range []string{"GLITCHTIP_DSN", "SENTRY_DSN"} {
+		if value == "" {
+			value = os.Getenv(name)
+		}
+	}
+	if value == "" {
+		if isURL(monitorArg) {
+			return nil, common.Validationf("--report-failure needs --dsn when the monitor is given by URL")
+		}
+		return nil, nil
+	}
+	dsn, err := glitchtip.ParseDSN(value)
+	if err != nil {
+		return nil, &common.ValidationError{Err: err}
+	}
+	return dsn, nil
+}
+
+// resolveTarget finds the heartbeat URL of a monitor given by URL, ID or
+// name
+func resolveTarget(ctx context.Context, monitorArg string) (*target, error) {
+	if isURL(monitorArg) {
+		return &target{client: glitchtip.NewClient("", ""), url: monitorArg}, nil
+	}
+
+	orgSlug, err := common.ResolveOrganization(heartbeatOrg)
+	if err != nil {
+		return nil, err
+	}
+	client, err := common.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	monitor, err := client.FindMonitor(ctx, orgSlug, monitorArg)
+	if err != nil {
+		return nil, err
+	}
+	url, err := client.HeartbeatURL(orgSlug, *monitor)
+	if err != nil {
+		return nil, err
+	}
+	return &target{client: client, orgSlug: orgSlug, monitor: monitor, url: url}, nil
+}
+
+// reportFailure sends an error event about the failed job
+func reportFailure(ctx context.Context, monitorArg string, dsn *glitchtip.DSN, command []string, jobErr *common.ExitStatusError, duration time.Duration, stderr string) (string, error) {
+	name := monitorArg
+	var client *glitchtip.Client
+	if dsn == nil || !isURL(monitorArg) {
+		t, err := resolveTarget(ctx, monitorArg)
+		if err != nil && dsn == nil {
+			return "", err
+		}
+		if err == nil {
+			name, client = t.name(), t.client
+			if dsn == nil {
+				if dsn, err = projectDSN(ctx, t); err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+	if client == nil {
+		client = glitchtip.NewClient("", "")
+	}
+
+	commandLine := strings.Join(command, " ")
+	hostname, _ := os.Hostname()
+	return client.SendEvent(ctx, dsn, glitchtip.StoreEvent{
+		Logger:      "glitchtipctl.heartbeat",
+		ServerName:  hostname,
+		Environment: heartbeatEnvironment,
+		Message:     fmt.Sprintf("Job of monitor %s failed with exit code %d: %s", name, jobErr.Code, commandLine),
+		Tags: map[string]string{
+			"monitor":   name,
+			"exit_code": fmt.Sprint(jobErr.Code),
+		},
+		Extra: map[string]interface{}{
+			"command":          commandLine,
+			"error":            jobErr.Err.Error(),
+			"duration_seconds": duration.Seconds(),
+			"stderr":           stderr,
+		},
+		// One issue per monitor, whatever the job printed
+		Fingerprint: []string{"glitchtipctl-heartbeat", name},
+	})
+}
+
+// projectDSN returns the DSN of the first active client key of the
+// monitor's project
+func projectDSN(ctx context.Context, t *target) (*glitchtip.DSN, error) {
+	if t.monitor.Project == "" {
+		return nil, common.Validationf("monitor %s has no project, give --dsn to report failures", t.monitor.Name)
+	}
+	projects, err := t.client.ListOrganizationProjects(ctx, t.orgSlug, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.ID != t.monitor.Project {
+			continue
+		}
+		keys, err := t.client.ListProjectKeys(ctx, t.orgSlug, project.Slug)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if key.IsActive {
+				return glitchtip.ParseDSN(key.DSN.Public)
+			}
+		}
+		return nil, fmt.Errorf("project %s has no active key: %w", project.Slug, glitchtip.ErrNotFound)
+	}
+	return nil, fmt.Errorf("project %s of monitor %s: %w", t.monitor.Project, t.monitor.Name, glitchtip.ErrNotFound)
+}
+
+// exitCode returns the exit code of a failed job. Jobs that could not be
+// started exit with 127, as in a shell.
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return 127
+	}
+	if code := exitErr.ExitCode(); code > 0 {
+		return code
+	}
+	// Killed by a signal
+	return 1
+}
+
+// isURL reports whether a monitor is given by its heartbeat URL
+func isURL(monitorArg string) bool {
+	return strings.HasPrefix(monitorArg, "http://") || strings.HasPrefix(monitorArg, "https://")
+}
+
+// tailBuffer keeps the last limit bytes written to it
+type tailBuffer struct {
+	limit     int
+	data      []byte
+	truncated bool
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.data = append(b.data, p...)
+	if len(b.data) > b.limit {
+		b.data = append([]byte(nil), b.data[len(b.data)-b.limit:]...)
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+// String returns the kept bytes, marking where earlier output was cut
+func (b *tailBuffer) String() string {
+	if b.truncated {
+		return "[...]\n" + string(b.data)
+	}
+	return string(b.data)
+}
+
+func init() {
+	common.AddOrgFlag(HeartbeatCmd, &heartbeatOrg)
+	HeartbeatCmd.Flags().BoolVar(&heartbeatReportFailure, "report-failure", false, "Report a failed job as an error event with its stderr")
+	HeartbeatCmd.Flags().StringVar(&heartbeatDSN, "dsn", "", "DSN to report failures with (defaults to $GLITCHTIP_DSN, $SENTRY_DSN or a key of the monitor's project)")
+	HeartbeatCmd.Flags().StringVarP(&heartbeatEnvironment, "environment", "e", "", "Environment of the failure event")
+}
@@ -0,0 +1,77 @@
+package heartbeat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestTailBuffer(t *testing.T) {
+	b := &tailBuffer{limit: 8}
+	b.Write([]byte("hello"))
+	if got := b.String(); got != "hello" {
+		t.Errorf("String() = %q, want hello", got)
+	}
+	b.Write([]byte(" world"))
+	if got := b.String(); got != "[...]\nlo world" {
+		t.Errorf("String() = %q, want the last 8 bytes", got)
+	}
+}
+
+func TestRunHeartbeat(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("no shell available")
+	}
+
+	var mu sync.Mutex
+	var requests []string
+	var event glitchtip.StoreEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, "/store/") {
+			json.NewDecoder(r.Body).Decode(&event)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	heartbeatURL := server.URL + "/api/0/organizations/acme/heartbeat_check/abc/"
+	defer func() { heartbeatReportFailure, heartbeatDSN = false, "" }()
+	ctx := context.Background()
+
+	if err := runHeartbeat(ctx, heartbeatURL, []string{"sh", "-c", "exit 0"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 || requests[0] != "POST /api/0/organizations/acme/heartbeat_check/abc/" {
+		t.Errorf("requests after success = %v, want one heartbeat", requests)
+	}
+
+	requests = nil
+	heartbeatReportFailure = true
+	heartbeatDSN = strings.Replace(server.URL, "://", "://key@", 1) + "/3"
+	err := runHeartbeat(ctx, heartbeatURL, []string{"sh", "-c", "echo disk full >&2; exit 3"})
+	if common.ExitCode(err) != 3 {
+		t.Errorf("runHeartbeat = %v with exit code %d, want 3", err, common.ExitCode(err))
+	}
+	if len(requests) != 1 || requests[0] != "POST /api/3/store/" {
+		t.Errorf("requests after failure = %v, want one event and no heartbeat", requests)
+	}
+	if event.Tags["exit_code"] != "3" || event.Extra["stderr"] != "disk full\n" {
+		t.Errorf("event = %+v", event)
+	}
+
+	err = runHeartbeat(ctx, heartbeatURL, []string{"glitchtipctl-no-such-command"})
+	if common.ExitCode(err) != 127 {
+		t.Errorf("runHeartbeat of a missing command = %v, want exit code 127", err)
+	}
+}
@@ -0,0 +1,69 @@
+package issue
+
+import (
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// IssuesCmd groups the commands that work with issues
+var IssuesCmd = &cobra.Command{
+	Use:   "issues",
+	Short: "List, search and triage the issues of an organization",
+	Long: `List, search and triage the issues of an organization. Issues are groups of similar error events
+reported by your projects.`,
+}
+
+// issuePrinter prints issues in every output format
+var issuePrinter = common.ResourcePrinter[glitchtip.Issue]{
+	Kind: "issue",
+	Name: func(issue glitchtip.Issue) string { return issue.ID.String() },
+	Columns: []common.Column[glitchtip.Issue]{
+		{Header: "ID", Value: func(issue glitchtip.Issue) string { return issue.ID.String() }},
+		{Header: "Short ID", Wide: true, Value: func(issue glitchtip.Issue) string { return issue.ShortID }},
+		{Header: "Project", Value: func(issue glitchtip.Issue) string {
+			if issue.Project == nil {
+				return ""
+			}
+			return issue.Project.Slug
+		}},
+		{Header: "Level", Value: func(issue glitchtip.Issue) string { return issue.Level }},
+		{Header: "Status", Value: func(issue glitchtip.Issue) string { return issue.Status }},
+		{Header: "Assignee", Wide: true, Value: Assignee},
+		{Header: "Events", Value: func(issue glitchtip.Issue) string { return issue.Count.String() }},
+		{Header: "Last Seen", Value: func(issue glitchtip.Issue) string { return issue.LastSeen }},
+		{Header: "First Seen", Wide: true, Value: func(issue glitchtip.Issue) string { return issue.FirstSeen }},
+		{Header: "Title", Value: func(issue glitchtip.Issue) string { return truncate(issue.Title, 80) }},
+		{Header: "Culprit", Wide: true, Value: func(issue glitchtip.Issue) string { return issue.Culprit }},
+	},
+}
+
+// Assignee returns the email or name of the user an issue is assigned to
+func Assignee(issue glitchtip.Issue) string {
+	if issue.AssignedTo == nil {
+		return ""
+	}
+	if issue.AssignedTo.Email != "" {
+		return issue.AssignedTo.Email
+	}
+	return issue.AssignedTo.Name
+}
+
+// truncate shortens text to at most n runes for table cells
+func truncate(text string, n int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+func init() {
+	IssuesCmd.AddCommand(newListCmd())
+	for _, action := range []triageAction{resolveAction, ignoreAction, unresolveAction, assignAction, deleteAction} {
+		IssuesCmd.AddCommand(newTriageCmd(action))
+	}
+}
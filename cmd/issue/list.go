@@ -0,0 +1,144 @@
+package issue
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// listFlags holds the flags of the issue list command
+type listFlags struct {
+	org          string
+	projects     []string
+	query        string
+	level        string
+	release      string
+	environments []string
+	sort         string
+	since        string
+	until        string
+}
+
+// NewGetCmd creates the "get issues" command, the issue list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "issues [search terms...]"
+	cmd.Aliases = []string{"issue"}
+	return cmd
+}
+
+// newListCmd creates the issues list command
+func newListCmd() *cobra.Command {
+	var flags listFlags
+
+	cmd := &cobra.Command{
+		Use:   "list [search terms...]",
+		Short: "List the issues of an organization",
+		Long: `List the issues of an organization, newest activity first.
+
+The --query flag takes GlitchTip's search syntax, for example "is:unresolved", "is:resolved",
+"is:ignored", "has:release" or "browser.name:Firefox". --level and --release add the matching
+search terms, and any remaining arguments are searched for as free text.`,
+		Example: `  # Unresolved errors of the web project seen in the last day
+  glitchtipctl issues list --project web --level error --since 24h
+
+  # Every issue of a release mentioning "timeout", most frequent first
+  glitchtipctl issues list --query "" --release 1.4.0 --sort freq timeout`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			filter, err := flags.filter(args)
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(flags.org)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			issues, err := common.FetchWithSpinner("Fetching issues...", func() ([]glitchtip.Issue, error) {
+				ctx := context.Background()
+				for _, projectSlug := range flags.projects {
+					project, err := client.GetProject(ctx, orgSlug, projectSlug)
+					if err != nil {
+						return nil, err
+					}
+					filter.ProjectIDs = append(filter.ProjectIDs, project.ID)
+				}
+				return client.ListIssues(ctx, orgSlug, filter, opts)
+			})
+			if err != nil {
+				return err
+			}
+			return issuePrinter.PrintList(os.Stdout, format, issues)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &flags.org)
+	cmd.Flags().StringSliceVarP(&flags.projects, "project", "p", nil, "Only list issues of these project slugs (repeatable)")
+	cmd.Flags().StringVar(&flags.query, "query", "is:unresolved", "Search query in GlitchTip syntax")
+	cmd.Flags().StringVar(&flags.level, "level", "", "Only list issues of this level (debug, info, warning, error, fatal)")
+	cmd.Flags().StringVar(&flags.release, "release", "", "Only list issues seen in this release")
+	cmd.Flags().StringSliceVarP(&flags.environments, "environment", "e", nil, "Only list issues seen in these environments (repeatable)")
+	cmd.Flags().StringVar(&flags.sort, "sort", "date", "Sort order: date, new, priority or freq")
+	cmd.Flags().StringVar(&flags.since, "since", "", "Only list issues seen after this time (e.g. 24h, 14d, 2024-05-01 or an RFC 3339 timestamp)")
+	cmd.Flags().StringVar(&flags.until, "until", "", "Only list issues seen before this time (same formats as --since)")
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
+}
+
+// filter builds the issue filter from the list flags and arguments
+func (f *listFlags) filter(args []string) (*glitchtip.IssueFilter, error) {
+	sort, err := glitchtip.ParseIssueSort(f.sort)
+	if err != nil {
+		return nil, common.Validationf("%v", err)
+	}
+
+	terms := []string{f.query}
+	if f.level != "" {
+		terms = append(terms, "level:"+f.level)
+	}
+	if f.release != "" {
+		terms = append(terms, "release:"+f.release)
+	}
+	terms = append(terms, args...)
+
+	now := time.Now()
+	start, err := common.ParseTime(f.since, now)
+	if err != nil {
+		return nil, err
+	}
+	end, err := common.ParseTime(f.until, now)
+	if err != nil {
+		return nil, err
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, common.Validationf("--until must be after --since")
+	}
+
+	return &glitchtip.IssueFilter{
+		Query:        strings.Join(strings.Fields(strings.Join(terms, " ")), " "),
+		Sort:         sort,
+		Environments: f.environments,
+		Start:        start,
+		End:          end,
+	}, nil
+}
@@ -0,0 +1,271 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// bulkBatchSize caps the number of issue IDs sent in one bulk request
+const bulkBatchSize = 100
+
+// triageAction describes one of the bulk issue commands
+type triageAction struct {
+	verb      string // resolve
+	past      string // resolved
+	status    string // the new status, empty for delete and assign
+	assign    bool   // whether the action assigns the issues, to --to
+	threshold int    // default number of issues changed without confirmation
+}
+
+// deletes reports whether the action deletes the issues instead of
+// updating them
+func (a triageAction) deletes() bool {
+	return a.status == "" && !a.assign
+}
+
+// The triage actions, each a subcommand of the issues command
+var (
+	resolveAction   = triageAction{verb: "resolve", past: "resolved", status: glitchtip.IssueStatusResolved, threshold: 10}
+	ignoreAction    = triageAction{verb: "ignore", past: "ignored", status: glitchtip.IssueStatusIgnored, threshold: 10}
+	unresolveAction = triageAction{verb: "unresolve", past: "unresolved", status: glitchtip.IssueStatusUnresolved, threshold: 10}
+	assignAction    = triageAction{verb: "assign", past: "assigned", assign: true, threshold: 10}
+	deleteAction    = triageAction{verb: "delete", past: "deleted", threshold: 0}
+)
+
+// NewDeleteCmd creates the "delete issues" command of the verb/noun
+// command tree
+func NewDeleteCmd() *cobra.Command {
+	cmd := newTriageCmd(deleteAction)
+	cmd.Use = "issues [issue_id...]"
+	cmd.Aliases = []string{"issue"}
+	return cmd
+}
+
+// newTriageCmd creates a command that applies action to issues selected by
+// ID or by a search query
+func newTriageCmd(action triageAction) *cobra.Command {
+	var orgFlag string
+	example := ""
+	if action.assign {
+		example = " --to ann@example.com"
+	}
+
+	cmd := &cobra.Command{
+		Use:   action.verb + " [issue_id...]",
+		Short: fmt.Sprintf("%s issues by ID or by search query", capitalize(action.verb)),
+		Long: fmt.Sprintf(`%s issues by ID or by search query.
+
+Issues are selected either by passing their IDs or with --query, which takes the same search
+syntax as "issues list". Use --dry-run to see which issues would be %s. A confirmation prompt
+is shown when more than --confirm-threshold issues are affected, unless --yes is given.`, capitalize(action.verb), action.past),
+		Example: fmt.Sprintf(`  glitchtipctl issues %[1]s 1042 1043%[2]s
+  glitchtipctl issues %[1]s --project web --query "is:unresolved release:1.3.0"%[2]s --dry-run`, action.verb, example),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTriage(cmd, args, orgFlag, action)
+		},
+	}
+
+	if action.assign {
+		cmd.Long += "\n\n--to takes the email of a member of the organization, me to assign the issues to yourself, or\nnone to unassign them."
+		cmd.Flags().String("to", "", "Email of the member to assign the issues to, me, or none to unassign (required)")
+		cmd.MarkFlagRequired("to")
+	}
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().String("query", "", "Select the issues matching this search query instead of passing IDs")
+	cmd.Flags().StringSliceP("project", "p", nil, "Only select issues of these project slugs when using --query (repeatable)")
+	cmd.Flags().Bool("dry-run", false, fmt.Sprintf("Only print the issues that would be %s", action.past))
+	cmd.Flags().BoolP("yes", "y", false, "Do not ask for confirmation")
+	cmd.Flags().Int("confirm-threshold", action.threshold, "Ask for confirmation when more than this many issues are affected")
+	common.AddListFlags(cmd)
+	return cmd
+}
+
+func runTriage(cmd *cobra.Command, args []string, orgFlag string, action triageAction) error {
+	flags := cmd.Flags()
+	query, _ := flags.GetString("query")
+	projectSlugs, _ := flags.GetStringSlice("project")
+	dryRun, _ := flags.GetBool("dry-run")
+	yes, _ := flags.GetBool("yes")
+	threshold, _ := flags.GetInt("confirm-threshold")
+
+	if len(args) == 0 && query == "" {
+		return common.Validationf("pass issue IDs or --query to select issues")
+	}
+	if len(args) > 0 && (query != "" || len(projectSlugs) > 0) {
+		return common.Validationf("issue IDs cannot be combined with --query or --project")
+	}
+	opts, err := common.ListOptions(cmd)
+	if err != nil {
+		return err
+	}
+	orgSlug, err := common.ResolveOrganization(orgFlag)
+	if err != nil {
+		return err
+	}
+	client, err := common.NewClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	// Look the issues up first, so a dry run and the confirmation show
+	// exactly the issues the bulk request will change
+	update := glitchtip.IssueUpdate{Status: action.status}
+	issues, err := common.FetchWithSpinner("Fetching issues...", func() ([]glitchtip.Issue, error) {
+		if action.assign {
+			to, _ := flags.GetString("to")
+			assignee, err := resolveAssignee(ctx, client, orgSlug, to)
+			if err != nil {
+				return nil, err
+			}
+			update.AssignedTo = assignee
+		}
+		if len(args) > 0 {
+			return getIssues(ctx, client, orgSlug, args)
+		}
+		filter := &glitchtip.IssueFilter{Query: query}
+		for _, projectSlug := range projectSlugs {
+			project, err := client.GetProject(ctx, orgSlug, projectSlug)
+			if err != nil {
+				return nil, err
+			}
+			filter.ProjectIDs = append(filter.ProjectIDs, project.ID)
+		}
+		return client.ListIssues(ctx, orgSlug, filter, opts)
+	})
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		common.Infof("No issues matched.")
+		return nil
+	}
+
+	if dryRun {
+		if err := issuePrinter.PrintList(os.Stdout, "table", issues); err != nil {
+			return err
+		}
+		common.Infof("%s would be %s (dry run).", countIssues(len(issues)), action.past)
+		return nil
+	}
+
+	if len(issues) > threshold && !yes {
+		if !common.IsInteractive() {
+			return common.Validationf("refusing to %s %s without --yes", action.verb, countIssues(len(issues)))
+		}
+		if err := issuePrinter.PrintList(os.Stderr, "table", issues); err != nil {
+			return err
+		}
+		ok, err := common.Confirm(fmt.Sprintf("%s %s?", capitalize(action.verb), countIssues(len(issues))))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("aborted")
+		}
+	}
+
+	ids := make([]glitchtip.ID, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	_, err = common.FetchWithSpinner(fmt.Sprintf("Updating %s...", countIssues(len(ids))), func() (struct{}, error) {
+		for start := 0; start < len(ids); start += bulkBatchSize {
+			batch := ids[start:min(start+bulkBatchSize, len(ids))]
+			var err error
+			if action.deletes() {
+				err = client.DeleteIssues(ctx, orgSlug, batch)
+			} else {
+				err = client.UpdateIssues(ctx, orgSlug, batch, update)
+			}
+			if err != nil {
+				return struct{}{}, fmt.Errorf("failed to %s issues: %w", action.verb, err)
+			}
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return err
+	}
+	common.Infof("%s %s.", capitalize(action.past), countIssues(len(ids)))
+	return nil
+}
+
+// getIssues looks up issues by ID, and rejects issues of other
+// organizations, which the bulk request to orgSlug would not change
+func getIssues(ctx context.Context, client *glitchtip.Client, orgSlug string, ids []string) ([]glitchtip.Issue, error) {
+	projects, err := client.ListOrganizationProjects(ctx, orgSlug, nil)
+	if err != nil {
+		return nil, err
+	}
+	inOrg := make(map[glitchtip.ID]bool, len(projects))
+	for _, project := range projects {
+		inOrg[project.ID] = true
+	}
+
+	issues := make([]glitchtip.Issue, 0, len(ids))
+	for _, id := range ids {
+		issue, err := client.GetIssue(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if issue.Project == nil || !inOrg[issue.Project.ID] {
+			return nil, common.Validationf("issue %s does not belong to a project of the organization %s", id, orgSlug)
+		}
+		issues = append(issues, *issue)
+	}
+	return issues, nil
+}
+
+// resolveAssignee returns the assignee of an update for --to: the email
+// of a member, "me" or "none"
+func resolveAssignee(ctx context.Context, client *glitchtip.Client, orgSlug, to string) (*string, error) {
+	switch to {
+	case "none":
+		none := ""
+		return &none, nil
+	case "me":
+		user, err := client.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return glitchtip.AssignUser(user.ID), nil
+	}
+	members, err := client.ListMembers(ctx, orgSlug, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if !strings.EqualFold(member.Email, to) {
+			continue
+		}
+		if member.User == nil {
+			return nil, common.Validationf("%s has not accepted the invitation to %s yet", member.Email, orgSlug)
+		}
+		return glitchtip.AssignUser(member.User.ID), nil
+	}
+	return nil, common.Validationf("%s is not a member of %s", to, orgSlug)
+}
+
+// countIssues formats an issue count for messages
+func countIssues(n int) string {
+	if n == 1 {
+		return "1 issue"
+	}
+	return fmt.Sprintf("%d issues", n)
+}
+
+// capitalize upper-cases the first letter of an ASCII word
+func capitalize(word string) string {
+	if word == "" {
+		return word
+	}
+	return strings.ToUpper(word[:1]) + word[1:]
+}
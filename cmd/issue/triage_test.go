@@ -0,0 +1,70 @@
+package issue
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestTriageActionDeletes(t *testing.T) {
+	for _, action := range []triageAction{resolveAction, ignoreAction, unresolveAction, assignAction} {
+		if action.deletes() {
+			t.Errorf("%s deletes issues", action.verb)
+		}
+	}
+	if !deleteAction.deletes() {
+		t.Error("delete does not delete issues")
+	}
+}
+
+func newTriageServer(t *testing.T) *glitchtip.Client {
+	t.Helper()
+	responses := map[string]string{
+		"/api/0/users/me/":                    `{"id":"1","email":"me@example.com"}`,
+		"/api/0/organizations/acme/members/":  `[{"id":7,"email":"ann@example.com","user":{"id":"3"}},{"id":8,"email":"bob@example.com","pending":true}]`,
+		"/api/0/organizations/acme/projects/": `[{"id":"10","slug":"web"}]`,
+		"/api/0/issues/1/":                    `{"id":"1","project":{"id":"10","slug":"web"}}`,
+		"/api/0/issues/2/":                    `{"id":"2","project":{"id":"20","slug":"other"}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return glitchtip.NewClient(server.URL, "token")
+}
+
+func TestResolveAssignee(t *testing.T) {
+	client := newTriageServer(t)
+	ctx := context.Background()
+	for to, want := range map[string]string{"Ann@Example.com": "user:3", "me": "user:1", "none": ""} {
+		assignee, err := resolveAssignee(ctx, client, "acme", to)
+		if err != nil || assignee == nil || *assignee != want {
+			t.Errorf("resolveAssignee(%q) = %v, %v, want %q", to, assignee, err, want)
+		}
+	}
+	for _, to := range []string{"bob@example.com", "zed@example.com"} {
+		if _, err := resolveAssignee(ctx, client, "acme", to); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("resolveAssignee(%q) = %v, want a validation error", to, err)
+		}
+	}
+}
+
+func TestGetIssuesRejectsOtherOrganizations(t *testing.T) {
+	client := newTriageServer(t)
+	ctx := context.Background()
+	if issues, err := getIssues(ctx, client, "acme", []string{"1"}); err != nil || len(issues) != 1 {
+		t.Errorf("getIssues = %v, %v", issues, err)
+	}
+	if _, err := getIssues(ctx, client, "acme", []string{"1", "2"}); common.ExitCode(err) != common.ExitValidation {
+		t.Errorf("getIssues of another organization's issue = %v, want a validation error", err)
+	}
+}
@@ -0,0 +1,68 @@
+package key
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd creates the "create key" command
+func NewCreateCmd() *cobra.Command {
+	cmd := newCreateCmd()
+	cmd.Use = "key"
+	cmd.Aliases = []string{"keys", "dsn"}
+	return cmd
+}
+
+// newCreateCmd creates the keys create command
+func newCreateCmd() *cobra.Command {
+	var flags projectFlags
+	var name, rateLimit string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a client key for a project",
+		Long:  `Create a client key for a project and print it with its DSN.`,
+		Example: `  glitchtipctl keys create --project my-org/web --name backend --rate-limit 1000/1h
+  glitchtipctl keys create --project web -o jsonpath='{.dsn.public}'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+			payload := glitchtip.ProjectKeyRequest{Name: name}
+			if rateLimit != "" {
+				if payload.RateLimit, err = parseRateLimit(rateLimit); err != nil {
+					return err
+				}
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			key, err := common.FetchWithSpinner("Creating key...", func() (*glitchtip.ProjectKey, error) {
+				return client.CreateProjectKey(context.Background(), orgSlug, projectSlug, payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Key %s created for project %s/%s", key.ID, orgSlug, projectSlug)
+			return keyPrinter.PrintObject(os.Stdout, format, *key)
+		},
+	}
+
+	flags.add(cmd)
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the key")
+	cmd.Flags().StringVar(&rateLimit, "rate-limit", "", "Maximum number of events per window, e.g. 1000/1h or 50/60s")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
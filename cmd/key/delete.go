@@ -0,0 +1,65 @@
+package key
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the "delete key" command
+func NewDeleteCmd() *cobra.Command {
+	cmd := newDeleteCmd()
+	cmd.Use = "key <key_id|name>"
+	cmd.Aliases = []string{"keys", "dsn"}
+	return cmd
+}
+
+// newDeleteCmd creates the keys delete command
+func newDeleteCmd() *cobra.Command {
+	var flags projectFlags
+
+	cmd := &cobra.Command{
+		Use:   "delete <key_id|name>",
+		Short: "Delete a client key of a project",
+		Long: `Delete a client key of a project. Events sent with its DSN are rejected afterwards.
+
+The key is given by its ID (the public key) or by its name. You are asked to type the key ID again
+to confirm, unless --yes is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var keyID string
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				key, err := client.FindProjectKey(ctx, orgSlug, projectSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				keyID = key.ID.String()
+				return &common.DeletionPlan{
+					Kind:  "key",
+					Name:  keyID,
+					Title: key.Name,
+					Details: []string{
+						"project: " + orgSlug + "/" + projectSlug,
+						"DSN: " + key.DSN.Public,
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteProjectKey(ctx, orgSlug, projectSlug, keyID)
+			})
+		},
+	}
+
+	flags.add(cmd)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,73 @@
+package key
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newGetDSNCmd creates the keys get-dsn command
+func newGetDSNCmd() *cobra.Command {
+	var flags projectFlags
+	var secret bool
+
+	cmd := &cobra.Command{
+		Use:   "get-dsn [key_id|name]",
+		Short: "Print only the DSN of a client key",
+		Long: `Print only the DSN of a client key, for use in scripts and deployment manifests. Without a key
+the DSN of the project's first active key is printed.`,
+		Example: `  export SENTRY_DSN=$(glitchtipctl keys get-dsn --project my-org/web)
+  glitchtipctl keys get-dsn backend --project web --secret`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			var key *glitchtip.ProjectKey
+			if len(args) > 0 {
+				key, err = client.FindProjectKey(ctx, orgSlug, projectSlug, args[0])
+			} else {
+				key, err = firstActiveKey(ctx, client, orgSlug, projectSlug)
+			}
+			if err != nil {
+				return err
+			}
+
+			dsn := key.DSN.Public
+			if secret {
+				dsn = key.DSN.Secret
+			}
+			fmt.Println(dsn)
+			return nil
+		},
+	}
+
+	flags.add(cmd)
+	cmd.Flags().BoolVar(&secret, "secret", false, "Print the secret DSN, which includes the secret key")
+	return cmd
+}
+
+// firstActiveKey returns the first client key of a project that accepts
+// events
+func firstActiveKey(ctx context.Context, client *glitchtip.Client, orgSlug, projectSlug string) (*glitchtip.ProjectKey, error) {
+	keys, err := client.ListProjectKeys(ctx, orgSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.IsActive {
+			return &key, nil
+		}
+	}
+	return nil, fmt.Errorf("project %s/%s has no active key: %w", orgSlug, projectSlug, glitchtip.ErrNotFound)
+}
@@ -0,0 +1,101 @@
+package key
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// KeysCmd groups the commands that manage the client keys (DSNs) of a
+// project
+var KeysCmd = &cobra.Command{
+	Use:     "keys",
+	Aliases: []string{"key"},
+	Short:   "Manage the client keys (DSNs) of a project",
+	Long: `Manage the client keys of a project. SDKs send events to GlitchTip through the DSN of a client
+key, so a project needs at least one active key.`,
+}
+
+// keyPrinter prints client keys in every output format
+var keyPrinter = common.ResourcePrinter[glitchtip.ProjectKey]{
+	Kind: "key",
+	Name: func(key glitchtip.ProjectKey) string { return key.ID.String() },
+	Columns: []common.Column[glitchtip.ProjectKey]{
+		{Header: "ID", Value: func(key glitchtip.ProjectKey) string { return key.ID.String() }},
+		{Header: "Name", Value: func(key glitchtip.ProjectKey) string { return key.Name }},
+		{Header: "Active", Value: func(key glitchtip.ProjectKey) string { return fmt.Sprintf("%t", key.IsActive) }},
+		{Header: "Rate Limit", Value: func(key glitchtip.ProjectKey) string { return formatRateLimit(key.RateLimit) }},
+		{Header: "DSN", Value: func(key glitchtip.ProjectKey) string { return key.DSN.Public }},
+		{Header: "Secret DSN", Wide: true, Value: func(key glitchtip.ProjectKey) string { return key.DSN.Secret }},
+		{Header: "Created", Wide: true, Value: func(key glitchtip.ProjectKey) string { return key.DateCreated }},
+	},
+}
+
+// projectFlags holds the flags that select the project of a key command
+type projectFlags struct {
+	org     string
+	project string
+}
+
+// add registers --project and --org on cmd
+func (f *projectFlags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.project, "project", "p", "", "Project slug, or <organization>/<project> (required)")
+	common.AddOrgFlag(cmd, &f.org)
+	cmd.MarkFlagRequired("project")
+}
+
+// resolve returns the organization and project slugs
+func (f *projectFlags) resolve() (string, string, error) {
+	return common.ResolveScopedSlug(f.project, f.org)
+}
+
+// parseRateLimit parses a rate limit of the form COUNT/WINDOW, e.g.
+// "1000/1h" or "50/60" for 50 events per 60 seconds. "none" removes the
+// limit.
+func parseRateLimit(value string) (*glitchtip.KeyRateLimit, error) {
+	if value == "none" {
+		return &glitchtip.KeyRateLimit{}, nil
+	}
+	countText, windowText, ok := strings.Cut(value, "/")
+	if !ok {
+		return nil, common.Validationf("rate limit %q must be of the form COUNT/WINDOW, e.g. 1000/1h", value)
+	}
+	count, err := strconv.Atoi(countText)
+	if err != nil || count <= 0 {
+		return nil, common.Validationf("rate limit count %q must be a positive number", countText)
+	}
+
+	window, err := time.ParseDuration(windowText)
+	if seconds, convErr := strconv.Atoi(windowText); convErr == nil {
+		window, err = time.Duration(seconds)*time.Second, nil
+	}
+	if err != nil || window < time.Second || window%time.Second != 0 {
+		return nil, common.Validationf("rate limit window %q must be a whole number of seconds or a duration such as 1m or 1h", windowText)
+	}
+	return &glitchtip.KeyRateLimit{Count: count, Window: int(window / time.Second)}, nil
+}
+
+// formatRateLimit renders a rate limit as e.g. "1000/1h"
+func formatRateLimit(limit *glitchtip.KeyRateLimit) string {
+	if limit == nil || limit.Count == 0 {
+		return "none"
+	}
+	window := limit.Window
+	switch {
+	case window%3600 == 0:
+		return fmt.Sprintf("%d/%dh", limit.Count, window/3600)
+	case window%60 == 0:
+		return fmt.Sprintf("%d/%dm", limit.Count, window/60)
+	default:
+		return fmt.Sprintf("%d/%ds", limit.Count, window)
+	}
+}
+
+func init() {
+	KeysCmd.AddCommand(newListCmd(), newCreateCmd(), newUpdateCmd(), newDeleteCmd(), newGetDSNCmd())
+}
@@ -0,0 +1,50 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  glitchtip.KeyRateLimit
+	}{
+		{"1000/1h", glitchtip.KeyRateLimit{Count: 1000, Window: 3600}},
+		{"50/60", glitchtip.KeyRateLimit{Count: 50, Window: 60}},
+		{"5/90s", glitchtip.KeyRateLimit{Count: 5, Window: 90}},
+		{"none", glitchtip.KeyRateLimit{}},
+	}
+	for _, tt := range tests {
+		got, err := parseRateLimit(tt.value)
+		if err != nil || *got != tt.want {
+			t.Errorf("parseRateLimit(%q) = %+v, %v, want %+v", tt.value, got, err, tt.want)
+		}
+	}
+
+	for _, value := range []string{"1000", "0/1h", "x/1h", "5/1.5s", "5/0", "5/abc"} {
+		if _, err := parseRateLimit(value); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("parseRateLimit(%q) = %v, want a validation error", value, err)
+		}
+	}
+}
+
+func TestFormatRateLimit(t *testing.T) {
+	tests := []struct {
+		limit *glitchtip.KeyRateLimit
+		want  string
+	}{
+		{nil, "none"},
+		{&glitchtip.KeyRateLimit{}, "none"},
+		{&glitchtip.KeyRateLimit{Count: 1000, Window: 7200}, "1000/2h"},
+		{&glitchtip.KeyRateLimit{Count: 50, Window: 120}, "50/2m"},
+		{&glitchtip.KeyRateLimit{Count: 5, Window: 90}, "5/90s"},
+	}
+	for _, tt := range tests {
+		if got := formatRateLimit(tt.limit); got != tt.want {
+			t.Errorf("formatRateLimit(%+v) = %q, want %q", tt.limit, got, tt.want)
+		}
+	}
+}
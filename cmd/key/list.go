@@ -0,0 +1,61 @@
+package key
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the "get keys" command, the key list in the verb/noun
+// command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "keys"
+	cmd.Aliases = []string{"key", "dsn", "dsns"}
+	return cmd
+}
+
+// newListCmd creates the keys list command
+func newListCmd() *cobra.Command {
+	var flags projectFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the client keys of a project",
+		Long: `List the client keys of a project with their DSN, rate limit and whether they are active. Use
+-o wide to show the secret DSNs as well.`,
+		Example: `  glitchtipctl keys list --project my-org/web
+  glitchtipctl keys list --project web -o jsonpath='{[*].dsn.public}'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			keys, err := common.FetchWithSpinner("Fetching keys...", func() ([]glitchtip.ProjectKey, error) {
+				return client.ListProjectKeys(context.Background(), orgSlug, projectSlug)
+			})
+			if err != nil {
+				return err
+			}
+			return keyPrinter.PrintList(os.Stdout, format, keys)
+		},
+	}
+
+	flags.add(cmd)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
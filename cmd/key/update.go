@@ -0,0 +1,88 @@
+package key
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateCmd creates the keys update command
+func newUpdateCmd() *cobra.Command {
+	var flags projectFlags
+	var name, rateLimit string
+	var enable, disable bool
+
+	cmd := &cobra.Command{
+		Use:   "update <key_id|name>",
+		Short: "Rename, enable or disable a client key, or change its rate limit",
+		Long: `Rename, enable or disable a client key, or change its rate limit. The key is given by its ID
+(the public key) or by its name. Only the given settings are changed.`,
+		Example: `  glitchtipctl keys update Default --project my-org/web --rate-limit 500/1m
+  glitchtipctl keys update 3b8f0c2a1e5d4f7a9c6b2d1e0f3a4b5c --project web --disable
+  glitchtipctl keys update backend --project web --rate-limit none`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, projectSlug, err := flags.resolve()
+			if err != nil {
+				return err
+			}
+
+			payload := glitchtip.ProjectKeyRequest{Name: name}
+			if enable || disable {
+				payload.IsActive = &enable
+			}
+			if rateLimit != "" {
+				if payload.RateLimit, err = parseRateLimit(rateLimit); err != nil {
+					return err
+				}
+			}
+			if payload == (glitchtip.ProjectKeyRequest{}) {
+				return common.Validationf("nothing to update, give at least one of --name, --enable, --disable or --rate-limit")
+			}
+
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+			key, err := common.FetchWithSpinner("Updating key...", func() (*glitchtip.ProjectKey, error) {
+				ctx := context.Background()
+				key, err := client.FindProjectKey(ctx, orgSlug, projectSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				// The key is replaced as a whole, so carry over what is unchanged
+				if payload.Name == "" {
+					payload.Name = key.Name
+				}
+				if payload.IsActive == nil {
+					payload.IsActive = &key.IsActive
+				}
+				if payload.RateLimit == nil {
+					payload.RateLimit = key.RateLimit
+				}
+				return client.UpdateProjectKey(ctx, orgSlug, projectSlug, key.ID.String(), payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Key %s updated", key.ID)
+			return keyPrinter.PrintObject(os.Stdout, format, *key)
+		},
+	}
+
+	flags.add(cmd)
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New name of the key")
+	cmd.Flags().BoolVar(&enable, "enable", false, "Accept events sent with the key")
+	cmd.Flags().BoolVar(&disable, "disable", false, "Reject events sent with the key")
+	cmd.Flags().StringVar(&rateLimit, "rate-limit", "", "Maximum number of events per window, e.g. 1000/1h, or none to remove the limit")
+	cmd.MarkFlagsMutuallyExclusive("enable", "disable")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
@@ -1,89 +1,204 @@
 package login
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
-    tea "github.com/charmbracelet/bubbletea"
-	"github.com/joho/godotenv"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
 	"github.com/spf13/cobra"
 )
 
-// Step 1: Define the model
-type model struct {
-	loading bool
-	message string
+var (
+	loginURL           string
+	loginToken         string
+	loginEmail         string
+	loginTokenStdin    bool
+	loginPasswordStdin bool
+)
+
+// LoginCmd verifies GlitchTip credentials and stores them in the active context
+var LoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in to your GlitchTip account",
+	Long: `Log in to a GlitchTip server and store the credentials in the active context.
+
+Authenticate either with an existing API token or with your email and password,
+in which case a new API token is created for glitchtipctl. Values that are not
+passed as flags are prompted for. The credentials are verified by fetching the
+current user before they are saved.
+
+Tokens are stored in the OS keyring when one is available and otherwise in
+credentials.yaml next to the config file, readable only by you. Use
+--token-stdin (or --email with --password-stdin) in CI so secrets never show
+up in shell history or the process list.
+
+Example usage:
+  glitchtipctl login
+  echo "$GLITCHTIP_TOKEN" | glitchtipctl login --url https://glitchtip.example.com --token-stdin
+  glitchtipctl --context staging login --email me@example.com`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return login(context.Background())
+	},
 }
 
-func initialModel() model {
-	return model{
-		loading: true,
-		message: "",
-	}
+// LogoutCmd removes the stored token from the active context
+var LogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the stored credentials of the active context",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, err := common.ClearLogin()
+		if err != nil {
+			return err
+		}
+		common.Infof("Logged out of context %q.", name)
+		return nil
+	},
 }
 
-// Step 2: Define messages
-type errMsg struct{ err error }
-type successMsg struct{}
+// WhoamiCmd prints the user the active context is authenticated as
+var WhoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show the user you are logged in as",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		active, err := common.ActiveContext()
+		if err != nil {
+			return err
+		}
+		client, err := common.NewClient()
+		if err != nil {
+			return err
+		}
 
-// Step 3: Implement the Bubble Tea Model interface
-func (m model) Init() bubbletea.Cmd {
-	return performLogin
-}
+		user, err := client.GetCurrentUser(context.Background())
+		if err != nil {
+			return err
+		}
 
-func (m model) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
-	switch msg := msg.(type) {
+		if active.Name != "" {
+			fmt.Printf("Context: %s\n", active.Name)
+		}
+		fmt.Printf("Server:  %s\n", client.BaseURL())
+		fmt.Printf("User:    %s <%s>\n", user.Name, user.Email)
+		return nil
+	},
+}
 
-	case errMsg:
-		return model{loading: false, message: "Login failed: " + msg.err.Error()}, nil
+func init() {
+	LoginCmd.Flags().StringVar(&loginURL, "url", "", "URL of the GlitchTip server")
+	LoginCmd.Flags().StringVar(&loginToken, "token", "", "API token to log in with")
+	LoginCmd.Flags().BoolVar(&loginTokenStdin, "token-stdin", false, "Read the API token from stdin")
+	LoginCmd.Flags().StringVar(&loginEmail, "email", "", "Email address to log in with")
+	LoginCmd.Flags().BoolVar(&loginPasswordStdin, "password-stdin", false, "Read the password from stdin instead of prompting for it")
+}
 
-	case successMsg:
-		return model{loading: false, message: "Login successful!"}, nil
+// login collects the credentials, verifies them and saves them
+func login(ctx context.Context) error {
+	config, err := common.LoadConfig()
+	if err != nil {
+		return err
+	}
+	interactive := common.IsInteractive()
 
-	default:
-		return m, nil
+	if loginTokenStdin && loginPasswordStdin {
+		return common.Validationf("--token-stdin and --password-stdin cannot be used together")
+	}
+	if loginPasswordStdin && loginEmail == "" {
+		return common.Validationf("--password-stdin requires --email")
 	}
-}
 
-func (m model) View() string {
-	if m.loading {
-		return "Attempting to log in...\n"
+	if loginPasswordStdin {
+		// The password arrives on stdin, so nothing can be prompted for.
+		interactive = false
 	}
-	return m.message + "\n"
-}
 
-// Step 4: Define command execution
-func performLogin() bubbletea.Msg {
-	// Simulate network request
-	time.Sleep(2 * time.Second) // This is where you'd make the actual network request
+	token := loginToken
+	if loginTokenStdin {
+		if token, err = common.ReadTokenFromStdin(); err != nil {
+			return err
+		}
+		// stdin is consumed, so nothing can be prompted for anymore.
+		interactive = false
+	}
 
-	// Here, replace this with actual logic to perform login
-	// For demonstration, we'll assume the login is successful
-	return successMsg{}
-}
+	url := loginURL
+	if url == "" {
+		url = glitchtip.DefaultURL
+		if existing := config.Context(config.ActiveContextName()); existing != nil && existing.URL != "" {
+			url = existing.URL
+		}
+		if interactive {
+			if url, err = common.Prompt("GlitchTip URL", url); err != nil {
+				return err
+			}
+		}
+	}
 
-// Step 5: Cobra command
-var LoginCmd = &cobra.Command{
-	Use:   "login",
-	Short: "Log in to your GlitchTip account",
-	Long:  `This command allows you to log in to your GlitchTip account.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		err := godotenv.Load()
-		if err != nil {
-			fmt.Println("Error loading .env file")
+	if token == "" && loginEmail == "" {
+		if !interactive {
+			return common.Validationf("--token or --email is required when not running in a terminal")
+		}
+		if token, err = common.PromptSecret("API token (leave empty to log in with email and password)"); err != nil {
 			return err
 		}
+	}
 
-		// Start the Bubble Tea program
-		p := bubbletea.NewProgram(initialModel())
-		if err := p.Start(); err != nil {
-			fmt.Printf("Could not start the login process: %v\n", err)
-			os.Exit(1)
+	if token == "" {
+		if token, err = loginWithPassword(ctx, url, interactive); err != nil {
+			return err
 		}
-		return nil
-	},
+	}
+
+	user, err := glitchtip.NewClient(url, token).GetCurrentUser(ctx)
+	if err != nil {
+		return fmt.Errorf("could not verify credentials: %w", err)
+	}
+
+	name, store, err := common.SaveLogin(url, token)
+	if err != nil {
+		return err
+	}
+	common.Infof("Logged in to %s as %s (context %q, token stored in %s).", url, user.Email, name, store)
+	return nil
 }
 
-func init() {
-	// Initialize your command here if needed
+// loginWithPassword signs in with email and password and returns the API
+// token created for glitchtipctl
+func loginWithPassword(ctx context.Context, url string, interactive bool) (string, error) {
+	var err error
+	email := loginEmail
+	if email == "" {
+		if email, err = common.Prompt("Email", ""); err != nil {
+			return "", err
+		}
+	}
+
+	var password string
+	switch {
+	case loginPasswordStdin:
+		if password, err = common.ReadSecretFromStdin("password"); err != nil {
+			return "", err
+		}
+	case interactive:
+		if password, err = common.PromptSecret("Password"); err != nil {
+			return "", err
+		}
+	default:
+		return "", common.Validationf("--password-stdin is required when not running in a terminal")
+	}
+
+	label := "glitchtipctl"
+	if hostname, err := os.Hostname(); err == nil {
+		label += " on " + hostname
+	}
+
+	apiToken, err := glitchtip.Login(ctx, url, email, password, label)
+	if err != nil {
+		return "", err
+	}
+	return apiToken.Token, nil
 }
@@ -0,0 +1,71 @@
+package member
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the "delete member" command
+func NewDeleteCmd() *cobra.Command {
+	cmd := newRemoveCmd()
+	cmd.Use = "member <email|member_id>"
+	cmd.Aliases = []string{"members", "user"}
+	return cmd
+}
+
+// newRemoveCmd creates the command that removes a member from an
+// organization
+func newRemoveCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "remove <email|member_id>",
+		Short: "Remove a user from an organization",
+		Long: `Remove a user from an organization, or withdraw a pending invitation. The user's account is kept.
+
+The user is given by email address or member ID. You are asked to type the email address again to
+confirm, unless --yes is given. Use --dry-run to see the membership and teams that would be removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var memberID string
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				member, err := client.FindMember(ctx, orgSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				memberID = member.ID.String()
+				status := "role: " + member.Role
+				if member.Pending {
+					status += " (pending invitation)"
+				}
+				return &common.DeletionPlan{
+					Kind:  "member",
+					Name:  member.Email,
+					Title: member.Name,
+					Details: []string{
+						"organization: " + orgSlug,
+						status,
+						common.Summarize("team", "teams", member.Teams),
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteMember(ctx, orgSlug, memberID)
+			})
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
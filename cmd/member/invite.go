@@ -0,0 +1,80 @@
+package member
+
+import (
+	"context"
+	"net/mail"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newInviteCmd creates the members invite command
+func newInviteCmd() *cobra.Command {
+	var orgFlag, role string
+	var teams []string
+
+	cmd := &cobra.Command{
+		Use:   "invite <email>...",
+		Short: "Invite users to an organization",
+		Long: `Invite users to an organization by email. They are listed as pending members until they accept
+the invitation, and join the given teams when they do.`,
+		Example: `  glitchtipctl members invite ann@example.com --role admin --teams backend,ops
+  glitchtipctl members invite bob@example.com carol@example.com --org my-org`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgRole, err := parseRole(role)
+			if err != nil {
+				return err
+			}
+			for _, email := range args {
+				if _, err := mail.ParseAddress(email); err != nil {
+					return common.Validationf("%q is not an email address", email)
+				}
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			invited, err := common.FetchWithSpinner("Inviting members...", func() ([]glitchtip.Member, error) {
+				var invited []glitchtip.Member
+				for _, email := range args {
+					member, err := client.InviteMember(context.Background(), orgSlug, glitchtip.MemberInviteRequest{
+						Email:      email,
+						OrgRole:    orgRole,
+						TeamRoles:  teamRoles(teams),
+						SendInvite: true,
+					})
+					if err != nil {
+						return invited, err
+					}
+					invited = append(invited, *member)
+				}
+				return invited, nil
+			})
+			for _, member := range invited {
+				common.Infof("Invited %s as %s", member.Email, orgRole)
+			}
+			if err != nil {
+				return err
+			}
+			return Printer.PrintList(os.Stdout, format, invited)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringVar(&role, "role", glitchtip.RoleMember, "Organization role: member, admin, manager or owner")
+	cmd.Flags().StringSliceVar(&teams, "teams", nil, "Slugs of the teams to add the users to, e.g. backend,ops")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,144 @@
+package member
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// MembersCmd groups the commands that manage the members of an
+// organization
+var MembersCmd = &cobra.Command{
+	Use:     "members",
+	Aliases: []string{"member"},
+	Short:   "Invite, list and remove the members of an organization",
+	Long: `Invite users to an organization, change their roles and remove them again. Invited users are
+listed as pending members until they accept the invitation.
+
+Members are given by email address or member ID. The roles, from least to most privileged, are
+member, admin, manager and owner.`,
+}
+
+// NewGetCmd creates the "get members" command, the member list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "members [organization_slug]"
+	cmd.Aliases = []string{"member"}
+	return cmd
+}
+
+// newListCmd creates the members list command
+func newListCmd() *cobra.Command {
+	var orgFlag string
+	var pending bool
+
+	cmd := &cobra.Command{
+		Use:   "list [organization_slug]",
+		Short: "Fetch the members of an organization by organizational slug",
+		Long: `Fetch and display the members of a specified organization by passing its slug, with their role
+and whether they have accepted their invitation. Use --pending to only list open invitations.`,
+		Args: cobra.MaximumNArgs(1), // The org slug defaults to the context organization
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Use the passed slug or fall back to the context organization
+			if len(args) > 0 {
+				if orgFlag != "" && orgFlag != args[0] {
+					return common.Validationf("the organization was given both as an argument and with --org")
+				}
+				orgFlag = args[0]
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			members, err := common.FetchWithSpinner("Fetching members...", func() ([]glitchtip.Member, error) {
+				return client.ListMembers(context.Background(), orgSlug, opts)
+			})
+			if err != nil {
+				return err
+			}
+			if pending {
+				invited := []glitchtip.Member{}
+				for _, member := range members {
+					if member.Pending {
+						invited = append(invited, member)
+					}
+				}
+				members = invited
+			}
+			return Printer.PrintList(os.Stdout, format, members)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().BoolVar(&pending, "pending", false, "Only list invitations that have not been accepted")
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
+}
+
+// Printer prints organization members in every output format. Team
+// member listings use it as well
+var Printer = common.ResourcePrinter[glitchtip.Member]{
+	Kind: "member",
+	Name: func(member glitchtip.Member) string { return member.Email },
+	Columns: []common.Column[glitchtip.Member]{
+		{Header: "ID", Value: func(member glitchtip.Member) string { return member.ID.String() }},
+		{Header: "Name", Value: func(member glitchtip.Member) string { return member.Name }},
+		{Header: "Email", Value: func(member glitchtip.Member) string { return member.Email }},
+		{Header: "Role", Value: func(member glitchtip.Member) string { return member.Role }},
+		{Header: "Status", Value: statusText},
+		{Header: "Teams", Wide: true, Value: func(member glitchtip.Member) string { return strings.Join(member.Teams, ",") }},
+		{Header: "Created", Wide: true, Value: func(member glitchtip.Member) string { return member.DateCreated }},
+	},
+}
+
+// statusText tells pending invitations from members who joined
+func statusText(member glitchtip.Member) string {
+	if member.Pending {
+		return "invited"
+	}
+	return "active"
+}
+
+// parseRole checks an organization role given on the command line
+func parseRole(role string) (string, error) {
+	for _, known := range glitchtip.Roles {
+		if strings.EqualFold(role, known) {
+			return known, nil
+		}
+	}
+	return "", common.Validationf("unknown role %q, expected one of %s", role, strings.Join(glitchtip.Roles, ", "))
+}
+
+// teamRoles adds a member to each of the teams
+func teamRoles(teams []string) []glitchtip.TeamRole {
+	roles := make([]glitchtip.TeamRole, len(teams))
+	for i, team := range teams {
+		roles[i] = glitchtip.TeamRole{TeamSlug: team}
+	}
+	return roles
+}
+
+func init() {
+	MembersCmd.AddCommand(newListCmd(), newInviteCmd(), newSetRoleCmd(), newRemoveCmd(), newResendInviteCmd())
+}
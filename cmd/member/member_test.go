@@ -0,0 +1,30 @@
+package member
+
+import (
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestParseRole(t *testing.T) {
+	for value, want := range map[string]string{"member": "member", "Admin": "admin", "OWNER": "owner", "manager": "manager"} {
+		if got, err := parseRole(value); err != nil || got != want {
+			t.Errorf("parseRole(%q) = %q, %v, want %q", value, got, err, want)
+		}
+	}
+	for _, value := range []string{"", "superuser", "members"} {
+		if _, err := parseRole(value); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("parseRole(%q) = %v, want a validation error", value, err)
+		}
+	}
+}
+
+func TestStatusText(t *testing.T) {
+	if got := statusText(glitchtip.Member{Pending: true}); got != "invited" {
+		t.Errorf("statusText(pending) = %q, want invited", got)
+	}
+	if got := statusText(glitchtip.Member{}); got != "active" {
+		t.Errorf("statusText(active) = %q, want active", got)
+	}
+}
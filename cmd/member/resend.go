@@ -0,0 +1,52 @@
+package member
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newResendInviteCmd creates the members resend-invite command
+func newResendInviteCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "resend-invite <email|member_id>...",
+		Short: "Send the invitation email of pending members again",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			for _, arg := range args {
+				member, err := common.FetchWithSpinner("Sending invitation...", func() (*glitchtip.Member, error) {
+					member, err := client.FindMember(ctx, orgSlug, arg)
+					if err != nil {
+						return nil, err
+					}
+					if !member.Pending {
+						return nil, common.Validationf("%s has already accepted the invitation", member.Email)
+					}
+					return member, client.ResendInvite(ctx, orgSlug, member.ID.String())
+				})
+				if err != nil {
+					return err
+				}
+				common.Infof("Sent the invitation to %s again", member.Email)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
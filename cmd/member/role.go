@@ -0,0 +1,70 @@
+package member
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newSetRoleCmd creates the members set-role command
+func newSetRoleCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "set-role <email|member_id> <role>",
+		Short: "Change the organization role of a member",
+		Long: `Change the organization role of a member or of a pending invitation. The roles, from least to
+most privileged, are member, admin, manager and owner. The member's teams are kept.`,
+		Example: `  glitchtipctl members set-role ann@example.com manager`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			role, err := parseRole(args[1])
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var previous string
+			member, err := common.FetchWithSpinner("Updating member...", func() (*glitchtip.Member, error) {
+				ctx := context.Background()
+				member, err := client.FindMember(ctx, orgSlug, args[0])
+				if err != nil || member.Role == role {
+					return member, err
+				}
+				previous = member.Role
+				// The teams are replaced as well, so send the current ones
+				return client.UpdateMember(ctx, orgSlug, member.ID.String(), glitchtip.MemberUpdateRequest{
+					OrgRole:   role,
+					TeamRoles: teamRoles(member.Teams),
+				})
+			})
+			if err != nil {
+				return err
+			}
+			if previous == "" {
+				common.Infof("%s already has the role %s", member.Email, role)
+			} else {
+				common.Infof("Changed the role of %s from %s to %s", member.Email, previous, role)
+			}
+			return Printer.PrintObject(os.Stdout, format, *member)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
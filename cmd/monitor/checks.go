@@ -0,0 +1,103 @@
+package monitor
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// defaultChecks is the number of checks shown without --limit
+const defaultChecks = 50
+
+// checkPrinter prints monitor checks in every output format
+var checkPrinter = common.ResourcePrinter[glitchtip.MonitorCheck]{
+	Kind: "check",
+	Name: func(check glitchtip.MonitorCheck) string { return check.StartCheck },
+	Columns: []common.Column[glitchtip.MonitorCheck]{
+		{Header: "Time", Value: func(check glitchtip.MonitorCheck) string { return check.StartCheck }},
+		{Header: "Status", Value: func(check glitchtip.MonitorCheck) string { return upText(check.IsUp) }},
+		{Header: "Response Time", Value: func(check glitchtip.MonitorCheck) string { return responseTime(check.ResponseTime) }},
+		{Header: "Reason", Value: func(check glitchtip.MonitorCheck) string { return string(check.Reason) }},
+	},
+}
+
+// newChecksCmd creates the monitors checks command
+func newChecksCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "checks <monitor_id|name>",
+		Short: "Show the recent checks of an uptime monitor and its uptime",
+		Long: `Show the recent checks of an uptime monitor, newest first, and the percentage of them that
+succeeded. The last 50 checks are shown unless --limit is given.`,
+		Example: `  glitchtipctl monitors checks API
+  glitchtipctl monitors checks 12 --limit 500 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var monitor *glitchtip.Monitor
+			checks, err := common.FetchWithSpinner("Fetching checks...", func() ([]glitchtip.MonitorCheck, error) {
+				ctx := context.Background()
+				if monitor, err = client.FindMonitor(ctx, orgSlug, args[0]); err != nil {
+					return nil, err
+				}
+				return client.ListMonitorChecks(ctx, orgSlug, monitor.ID.String(), opts)
+			})
+			if err != nil {
+				return err
+			}
+			if err := checkPrinter.PrintList(os.Stdout, format, checks); err != nil {
+				return err
+			}
+			if uptime := glitchtip.Uptime(checks); uptime >= 0 {
+				common.Infof("%s is %s, uptime %.2f%% over the last %d checks", monitor.Name, status(*monitor), uptime, len(checks))
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	limit := cmd.Flags().Lookup("limit")
+	limit.Value.Set(strconv.Itoa(defaultChecks))
+	limit.DefValue = limit.Value.String()
+	return cmd
+}
+
+// upText labels a check as up or down
+func upText(up bool) string {
+	if up {
+		return "up"
+	}
+	return "down"
+}
+
+// responseTime renders the duration of a check in milliseconds
+func responseTime(t glitchtip.ResponseTime) string {
+	if t == 0 {
+		return ""
+	}
+	return strconv.FormatInt(time.Duration(t).Milliseconds(), 10) + " ms"
+}
@@ -0,0 +1,70 @@
+package monitor
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd creates the "create monitor" command
+func NewCreateCmd() *cobra.Command {
+	cmd := newCreateCmd()
+	cmd.Use = "monitor"
+	cmd.Aliases = []string{"monitors"}
+	return cmd
+}
+
+// newCreateCmd creates the monitors create command
+func newCreateCmd() *cobra.Command {
+	var orgFlag string
+	var flags settingsFlags
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an uptime monitor",
+		Long: `Create an uptime monitor. HTTP monitors expect --expected-status, keyword monitors additionally
+expect --keyword in the response. Heartbeat monitors need no URL; ping them at the endpoint shown
+after creating them.`,
+		Example: `  glitchtipctl monitors create --name API --url https://api.example.com/health --interval 1m
+  glitchtipctl monitors create --name Shop --type keyword --url https://shop.example.com --keyword "Add to cart"
+  glitchtipctl monitors create --name "Nightly backup" --type heartbeat --interval 24h --project backups`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			monitor, err := common.FetchWithSpinner("Creating monitor...", func() (*glitchtip.Monitor, error) {
+				ctx := context.Background()
+				var payload glitchtip.MonitorRequest
+				if err := flags.apply(ctx, cmd, client, orgSlug, &payload, true); err != nil {
+					return nil, err
+				}
+				return client.CreateMonitor(ctx, orgSlug, payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Monitor %s created", monitor.ID)
+			return monitorPrinter.PrintObject(os.Stdout, format, *monitor)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	flags.add(cmd)
+	cmd.MarkFlagRequired("name")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the "delete monitor" command
+func NewDeleteCmd() *cobra.Command {
+	cmd := newDeleteCmd()
+	cmd.Use = "monitor <monitor_id|name>"
+	cmd.Aliases = []string{"monitors"}
+	return cmd
+}
+
+// newDeleteCmd creates the monitors delete command
+func newDeleteCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "delete <monitor_id|name>",
+		Short: "Delete an uptime monitor",
+		Long: `Delete an uptime monitor with its check history. Use "monitors pause" to stop checking for a
+while instead.
+
+You are asked to type the monitor ID again to confirm, unless --yes is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var monitorID string
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				monitor, err := client.FindMonitor(ctx, orgSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				monitorID = monitor.ID.String()
+				return &common.DeletionPlan{
+					Kind:  "monitor",
+					Name:  monitorID,
+					Title: monitor.Name,
+					Details: []string{
+						"type: " + typeName(*monitor),
+						"target: " + target(*monitor),
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteMonitor(ctx, orgSlug, monitorID)
+			})
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
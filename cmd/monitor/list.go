@@ -0,0 +1,59 @@
+package monitor
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the "get monitors" command, the monitor list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "monitors"
+	cmd.Aliases = []string{"monitor", "uptime"}
+	return cmd
+}
+
+// newListCmd creates the monitors list command
+func newListCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the uptime monitors of an organization",
+		Long: `List the uptime monitors of an organization with their target, interval and current status. Use
+-o wide to show the expected status, keyword, timeout and project as well.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			monitors, err := common.FetchWithSpinner("Fetching monitors...", func() ([]glitchtip.Monitor, error) {
+				return client.ListMonitors(context.Background(), orgSlug)
+			})
+			if err != nil {
+				return err
+			}
+			return monitorPrinter.PrintList(os.Stdout, format, monitors)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
@@ -0,0 +1,227 @@
+package monitor
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// MonitorsCmd groups the commands that manage uptime monitors
+var MonitorsCmd = &cobra.Command{
+	Use:     "monitors",
+	Aliases: []string{"monitor", "uptime"},
+	Short:   "Manage the uptime monitors of an organization",
+	Long: `Manage the uptime monitors of an organization. HTTP, keyword, ping and SSL monitors check a URL
+at a fixed interval; heartbeat monitors expect to be pinged, e.g. by "glitchtipctl heartbeat".
+
+Monitors are given by ID or by name.`,
+}
+
+// monitorPrinter prints monitors in every output format
+var monitorPrinter = common.ResourcePrinter[glitchtip.Monitor]{
+	Kind: "monitor",
+	Name: func(monitor glitchtip.Monitor) string { return monitor.ID.String() },
+	Columns: []common.Column[glitchtip.Monitor]{
+		{Header: "ID", Value: func(monitor glitchtip.Monitor) string { return monitor.ID.String() }},
+		{Header: "Name", Value: func(monitor glitchtip.Monitor) string { return monitor.Name }},
+		{Header: "Type", Value: func(monitor glitchtip.Monitor) string { return typeName(monitor) }},
+		{Header: "Target", Value: func(monitor glitchtip.Monitor) string { return target(monitor) }},
+		{Header: "Interval", Value: func(monitor glitchtip.Monitor) string { return formatSeconds(monitor.Interval) }},
+		{Header: "Status", Value: func(monitor glitchtip.Monitor) string { return status(monitor) }},
+		{Header: "Last Change", Value: func(monitor glitchtip.Monitor) string { return monitor.LastChange }},
+		{Header: "Expected Status", Wide: true, Value: func(monitor glitchtip.Monitor) string { return expectedStatus(monitor) }},
+		{Header: "Keyword", Wide: true, Value: func(monitor glitchtip.Monitor) string { return monitor.ExpectedBody }},
+		{Header: "Timeout", Wide: true, Value: func(monitor glitchtip.Monitor) string { return formatTimeout(monitor.Timeout) }},
+		{Header: "Project", Wide: true, Value: func(monitor glitchtip.Monitor) string { return monitor.ProjectName }},
+	},
+}
+
+// monitorTypes maps the --type values to the server's monitor types
+var monitorTypes = map[string]string{
+	"http":      glitchtip.MonitorTypeGET,
+	"keyword":   glitchtip.MonitorTypeGET,
+	"post":      glitchtip.MonitorTypePOST,
+	"ping":      glitchtip.MonitorTypePing,
+	"ssl":       glitchtip.MonitorTypeSSL,
+	"heartbeat": glitchtip.MonitorTypeHeartbeat,
+}
+
+// typeName returns the --type value that describes a monitor
+func typeName(monitor glitchtip.Monitor) string {
+	if monitor.MonitorType == glitchtip.MonitorTypeGET {
+		if monitor.ExpectedBody != "" {
+			return "keyword"
+		}
+		return "http"
+	}
+	return strings.ToLower(monitor.MonitorType)
+}
+
+// target returns the URL a monitor checks, or the URL a heartbeat monitor
+// is pinged at
+func target(monitor glitchtip.Monitor) string {
+	if monitor.MonitorType == glitchtip.MonitorTypeHeartbeat {
+		return monitor.HeartbeatEndpoint
+	}
+	return monitor.URL
+}
+
+// status summarizes the state of a monitor
+func status(monitor glitchtip.Monitor) string {
+	switch {
+	case monitor.IsPaused:
+		return "paused"
+	case monitor.IsUp == nil:
+		return "pending"
+	case *monitor.IsUp:
+		return "up"
+	default:
+		return "down"
+	}
+}
+
+// expectedStatus renders the HTTP status a monitor expects
+func expectedStatus(monitor glitchtip.Monitor) string {
+	if monitor.ExpectedStatus == nil {
+		return ""
+	}
+	return strconv.Itoa(*monitor.ExpectedStatus)
+}
+
+// formatTimeout renders an optional timeout in seconds
+func formatTimeout(timeout *int) string {
+	if timeout == nil {
+		return ""
+	}
+	return formatSeconds(*timeout)
+}
+
+// formatSeconds renders a number of seconds as e.g. "5m" or "90s"
+func formatSeconds(seconds int) string {
+	text := (time.Duration(seconds) * time.Second).String()
+	if strings.HasSuffix(text, "m0s") {
+		text = strings.TrimSuffix(text, "0s")
+	}
+	if strings.HasSuffix(text, "h0m") {
+		text = strings.TrimSuffix(text, "0m")
+	}
+	return text
+}
+
+// parseSeconds parses a whole number of seconds or a duration such as
+// "5m"
+func parseSeconds(flag, value string) (int, error) {
+	duration, err := time.ParseDuration(value)
+	if seconds, convErr := strconv.Atoi(value); convErr == nil {
+		duration, err = time.Duration(seconds)*time.Second, nil
+	}
+	if err != nil || duration < time.Second || duration%time.Second != 0 {
+		return 0, common.Validationf("--%s %q must be a whole number of seconds or a duration such as 30s or 5m", flag, value)
+	}
+	return int(duration / time.Second), nil
+}
+
+// settingsFlags holds the flags that configure a monitor
+type settingsFlags struct {
+	name           string
+	monitorType    string
+	url            string
+	interval       string
+	timeout        string
+	keyword        string
+	project        string
+	expectedStatus int
+}
+
+// add registers the settings flags on cmd
+func (f *settingsFlags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.name, "name", "n", "", "Name of the monitor")
+	cmd.Flags().StringVar(&f.monitorType, "type", "http", "Monitor type: http, keyword, post, ping, ssl or heartbeat")
+	cmd.Flags().StringVar(&f.url, "url", "", "URL to check (not used by heartbeat monitors)")
+	cmd.Flags().StringVar(&f.interval, "interval", "60s", "Time between checks, or the longest time between heartbeats")
+	cmd.Flags().StringVar(&f.timeout, "timeout", "", "Time to wait for a response, e.g. 20s")
+	cmd.Flags().IntVar(&f.expectedStatus, "expected-status", 200, "HTTP status of a successful check, 0 for any")
+	cmd.Flags().StringVar(&f.keyword, "keyword", "", "Text the response must contain (keyword monitors)")
+	cmd.Flags().StringVarP(&f.project, "project", "p", "", "Slug of the project whose uptime alerts the monitor triggers")
+}
+
+// apply writes the settings to payload. Only flags given on the command
+// line are applied, unless all is set.
+func (f *settingsFlags) apply(ctx context.Context, cmd *cobra.Command, client *glitchtip.Client, orgSlug string, payload *glitchtip.MonitorRequest, all bool) error {
+	changed := func(name string) bool { return all || cmd.Flags().Changed(name) }
+
+	if changed("name") {
+		payload.Name = f.name
+	}
+	if changed("type") {
+		monitorType, ok := monitorTypes[strings.ToLower(f.monitorType)]
+		if !ok {
+			return common.Validationf("unknown monitor type %q, expected http, keyword, post, ping, ssl or heartbeat", f.monitorType)
+		}
+		payload.MonitorType = monitorType
+		if strings.ToLower(f.monitorType) == "http" && !cmd.Flags().Changed("keyword") {
+			payload.ExpectedBody = ""
+		}
+		if strings.EqualFold(f.monitorType, "keyword") && f.keyword == "" && payload.ExpectedBody == "" {
+			return common.Validationf("keyword monitors need --keyword")
+		}
+	}
+	if changed("url") {
+		payload.URL = f.url
+	}
+	if changed("interval") {
+		interval, err := parseSeconds("interval", f.interval)
+		if err != nil {
+			return err
+		}
+		payload.Interval = interval
+	}
+	if cmd.Flags().Changed("timeout") {
+		timeout, err := parseSeconds("timeout", f.timeout)
+		if err != nil {
+			return err
+		}
+		payload.Timeout = &timeout
+	}
+	if changed("keyword") {
+		payload.ExpectedBody = f.keyword
+	}
+	if changed("expected-status") {
+		payload.ExpectedStatus = nil
+		if f.expectedStatus != 0 {
+			payload.ExpectedStatus = &f.expectedStatus
+		}
+	}
+	if f.project != "" && cmd.Flags().Changed("project") {
+		project, err := client.GetProject(ctx, orgSlug, f.project)
+		if err != nil {
+			return err
+		}
+		payload.Project = project.ID
+	}
+
+	switch payload.MonitorType {
+	case glitchtip.MonitorTypeGET, glitchtip.MonitorTypePOST:
+	case glitchtip.MonitorTypeHeartbeat:
+		payload.URL, payload.ExpectedStatus, payload.ExpectedBody = "", nil, ""
+	default:
+		// Ping and SSL monitors do not look at the response
+		payload.ExpectedStatus, payload.ExpectedBody = nil, ""
+	}
+	if payload.Name == "" {
+		return common.Validationf("the monitor needs a --name")
+	}
+	if payload.URL == "" && payload.MonitorType != glitchtip.MonitorTypeHeartbeat {
+		return common.Validationf("%s monitors need a --url", typeName(glitchtip.Monitor{MonitorType: payload.MonitorType, ExpectedBody: payload.ExpectedBody}))
+	}
+	return nil
+}
+
+func init() {
+	MonitorsCmd.AddCommand(newListCmd(), newCreateCmd(), newUpdateCmd(), newDeleteCmd(), newPauseCmd(true), newPauseCmd(false), newChecksCmd())
+}
@@ -0,0 +1,103 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+func TestParseSeconds(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{"60", 60},
+		{"90s", 90},
+		{"5m", 300},
+		{"24h", 86400},
+	}
+	for _, tt := range tests {
+		got, err := parseSeconds("interval", tt.value)
+		if err != nil || got != tt.want {
+			t.Errorf("parseSeconds(%q) = %d, %v, want %d", tt.value, got, err, tt.want)
+		}
+	}
+	for _, value := range []string{"0", "-5", "1.5s", "500ms", "soon"} {
+		if _, err := parseSeconds("interval", value); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("parseSeconds(%q) = %v, want a validation error", value, err)
+		}
+	}
+}
+
+func TestFormatSeconds(t *testing.T) {
+	tests := map[int]string{5: "5s", 60: "1m", 90: "1m30s", 600: "10m", 3600: "1h", 5400: "1h30m", 86400: "24h"}
+	for seconds, want := range tests {
+		if got := formatSeconds(seconds); got != want {
+			t.Errorf("formatSeconds(%d) = %q, want %q", seconds, got, want)
+		}
+	}
+}
+
+// applyFlags parses args as settings flags and applies them to payload
+func applyFlags(t *testing.T, payload *glitchtip.MonitorRequest, all bool, args ...string) error {
+	t.Helper()
+	var flags settingsFlags
+	cmd := &cobra.Command{}
+	flags.add(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return flags.apply(context.Background(), cmd, nil, "acme", payload, all)
+}
+
+func TestApplyCreate(t *testing.T) {
+	var payload glitchtip.MonitorRequest
+	err := applyFlags(t, &payload, true, "--name", "Shop", "--type", "keyword", "--url", "https://shop.example.com", "--keyword", "cart", "--timeout", "10s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.MonitorType != glitchtip.MonitorTypeGET || payload.ExpectedBody != "cart" || payload.Interval != 60 ||
+		payload.ExpectedStatus == nil || *payload.ExpectedStatus != 200 || payload.Timeout == nil || *payload.Timeout != 10 {
+		t.Errorf("payload = %+v", payload)
+	}
+
+	payload = glitchtip.MonitorRequest{}
+	if err := applyFlags(t, &payload, true, "--name", "Backup", "--type", "heartbeat", "--interval", "24h"); err != nil {
+		t.Fatal(err)
+	}
+	if payload.MonitorType != glitchtip.MonitorTypeHeartbeat || payload.ExpectedStatus != nil || payload.Interval != 86400 {
+		t.Errorf("heartbeat payload = %+v", payload)
+	}
+
+	for _, args := range [][]string{
+		{"--name", "API"},
+		{"--name", "Shop", "--type", "keyword", "--url", "https://shop.example.com"},
+		{"--name", "API", "--type", "tcp", "--url", "https://example.com"},
+	} {
+		payload = glitchtip.MonitorRequest{}
+		if err := applyFlags(t, &payload, true, args...); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("apply(%v) = %v, want a validation error", args, err)
+		}
+	}
+}
+
+func TestApplyUpdate(t *testing.T) {
+	status := 200
+	payload := glitchtip.MonitorRequest{Name: "Shop", MonitorType: glitchtip.MonitorTypeGET, URL: "https://shop.example.com", ExpectedStatus: &status, ExpectedBody: "cart", Interval: 60}
+	if err := applyFlags(t, &payload, false, "--interval", "30s"); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Interval != 30 || payload.ExpectedBody != "cart" || payload.Name != "Shop" {
+		t.Errorf("payload = %+v, want only the interval changed", payload)
+	}
+
+	if err := applyFlags(t, &payload, false, "--type", "http", "--expected-status", "0"); err != nil {
+		t.Fatal(err)
+	}
+	if payload.ExpectedBody != "" || payload.ExpectedStatus != nil {
+		t.Errorf("payload = %+v, want a plain HTTP monitor accepting any status", payload)
+	}
+}
@@ -0,0 +1,70 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newPauseCmd creates the monitors pause command, or the resume command
+// when pause is false
+func newPauseCmd(pause bool) *cobra.Command {
+	var orgFlag string
+
+	verb, done := "resume", "resumed"
+	short := "Resume checking a paused uptime monitor"
+	if pause {
+		verb, done = "pause", "paused"
+		short = "Stop checking an uptime monitor until it is resumed"
+	}
+
+	cmd := &cobra.Command{
+		Use:   verb + " <monitor_id|name>...",
+		Short: short,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			for _, arg := range args {
+				unchanged := false
+				monitor, err := common.FetchWithSpinner(fmt.Sprintf("Updating monitor %s...", arg), func() (*glitchtip.Monitor, error) {
+					monitor, err := client.FindMonitor(ctx, orgSlug, arg)
+					if err != nil || monitor.IsPaused == pause {
+						unchanged = err == nil
+						return monitor, err
+					}
+					payload := monitor.Request()
+					payload.IsPaused = pause
+					return client.UpdateMonitor(ctx, orgSlug, monitor.ID.String(), payload)
+				})
+				if err != nil {
+					return err
+				}
+				if unchanged {
+					common.Infof("Monitor %s (%s) is already %s", monitor.ID, monitor.Name, done)
+					continue
+				}
+				// Servers without pausing ignore the field
+				if monitor.IsPaused != pause {
+					return fmt.Errorf("the server did not %s monitor %s, it may not support pausing monitors", verb, monitor.ID)
+				}
+				common.Infof("Monitor %s (%s) %s", monitor.ID, monitor.Name, done)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
@@ -0,0 +1,77 @@
+package monitor
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newUpdateCmd creates the monitors update command
+func newUpdateCmd() *cobra.Command {
+	var orgFlag string
+	var flags settingsFlags
+
+	cmd := &cobra.Command{
+		Use:   "update <monitor_id|name>",
+		Short: "Change the settings of an uptime monitor",
+		Long:  `Change the settings of an uptime monitor. Only the given settings are changed.`,
+		Example: `  glitchtipctl monitors update API --interval 30s --timeout 10s
+  glitchtipctl monitors update 12 --type keyword --keyword "status: ok"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().NFlag() == countFlags(cmd, "org", "output") {
+				return common.Validationf("nothing to update, give at least one setting such as --interval or --url")
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			monitor, err := common.FetchWithSpinner("Updating monitor...", func() (*glitchtip.Monitor, error) {
+				ctx := context.Background()
+				monitor, err := client.FindMonitor(ctx, orgSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				// The monitor is replaced as a whole, so start from its settings
+				payload := monitor.Request()
+				if err := flags.apply(ctx, cmd, client, orgSlug, &payload, false); err != nil {
+					return nil, err
+				}
+				return client.UpdateMonitor(ctx, orgSlug, monitor.ID.String(), payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Monitor %s updated", monitor.ID)
+			return monitorPrinter.PrintObject(os.Stdout, format, *monitor)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	flags.add(cmd)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// countFlags counts how many of the named flags were given
+func countFlags(cmd *cobra.Command, names ...string) int {
+	count := 0
+	for _, name := range names {
+		if cmd.Flags().Changed(name) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,76 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the command that deletes an organization
+func NewDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "organization <organization_slug>",
+		Aliases: []string{"org"},
+		Short:   "Delete an organization with all of its teams, projects and events",
+		Long: `Delete an organization with all of its teams, projects and events.
+
+You are asked to type the organization slug again to confirm, unless --yes is given. Use --dry-run
+to list the teams, projects and members that would be removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+			orgSlug := args[0]
+
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				org, err := client.GetOrganization(ctx, orgSlug)
+				if err != nil {
+					return nil, err
+				}
+				teams, err := client.ListOrganizationTeams(ctx, orgSlug, nil)
+				if err != nil {
+					return nil, err
+				}
+				projects, err := client.ListOrganizationProjects(ctx, orgSlug, nil)
+				if err != nil {
+					return nil, err
+				}
+				members, err := client.ListMembers(ctx, orgSlug, nil)
+				if err != nil {
+					return nil, err
+				}
+
+				teamSlugs := make([]string, len(teams))
+				for i, team := range teams {
+					teamSlugs[i] = team.Slug
+				}
+				projectSlugs := make([]string, len(projects))
+				for i, project := range projects {
+					projectSlugs[i] = project.Slug
+				}
+				memberEmails := make([]string, len(members))
+				for i, member := range members {
+					memberEmails[i] = member.Email
+				}
+				return &common.DeletionPlan{
+					Kind:  "organization",
+					Name:  org.Slug,
+					Title: org.Name,
+					Details: []string{
+						common.Summarize("team", "teams", teamSlugs),
+						common.Summarize("project", "projects", projectSlugs),
+						common.Summarize("member", "members", memberEmails),
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteOrganization(ctx, orgSlug)
+			})
+		},
+	}
+
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,115 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// organizationDescription is everything describe shows about an
+// organization
+type organizationDescription struct {
+	Organization *glitchtip.Organization
+	Members      []glitchtip.Member
+	Teams        []glitchtip.Team
+	Projects     []glitchtip.Project
+}
+
+// NewDescribeCmd creates the command that shows an organization in detail
+func NewDescribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "organization [organization_slug]",
+		Aliases: []string{"organizations", "orgs", "org"},
+		Short:   "Show an organization with its settings, members, teams and projects",
+		Long: `Show an organization with its settings, members, teams and projects. Without an argument the
+default organization of the current context is shown.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var orgFlag string
+			if len(args) > 0 {
+				orgFlag = args[0]
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			description, err := common.FetchWithSpinner("Fetching organization...", func() (*organizationDescription, error) {
+				return describeOrganization(context.Background(), client, orgSlug)
+			})
+			if err != nil {
+				return err
+			}
+			return printOrganizationDescription(description)
+		},
+	}
+
+	return cmd
+}
+
+// describeOrganization fetches the organization and its related objects
+func describeOrganization(ctx context.Context, client *glitchtip.Client, orgSlug string) (*organizationDescription, error) {
+	org, err := client.GetOrganization(ctx, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+	description := &organizationDescription{Organization: org}
+
+	if description.Members, err = client.ListMembers(ctx, orgSlug, nil); err != nil {
+		return nil, err
+	}
+	if description.Teams, err = client.ListOrganizationTeams(ctx, orgSlug, nil); err != nil {
+		return nil, err
+	}
+	if description.Projects, err = client.ListOrganizationProjects(ctx, orgSlug, nil); err != nil {
+		return nil, err
+	}
+	return description, nil
+}
+
+// printOrganizationDescription writes the organization view to stdout
+func printOrganizationDescription(description *organizationDescription) error {
+	org := description.Organization
+	d := common.NewDescriptionWriter(os.Stdout)
+	d.Field(0, "Name", org.Name)
+	d.Field(0, "Slug", org.Slug)
+	d.Field(0, "ID", org.ID)
+	d.Field(0, "Status", org.Status.Name)
+	d.Field(0, "Created", org.DateCreated)
+	d.Field(0, "Require 2FA", org.Require2FA)
+	d.Field(0, "Accepting Events", org.IsAcceptingEvents)
+	d.Field(0, "Early Adopter", org.IsEarlyAdopter)
+
+	d.Section(0, "Members", len(description.Members) == 0)
+	for _, member := range description.Members {
+		role := member.Role
+		if member.Pending {
+			role += " (invited)"
+		}
+		d.Row(1, member.Email, member.Name, role, strings.Join(member.Teams, ", "))
+	}
+
+	d.Section(0, "Teams", len(description.Teams) == 0)
+	for _, team := range description.Teams {
+		d.Row(1, team.Slug, fmt.Sprintf("%d members", team.MemberCount))
+	}
+
+	d.Section(0, "Projects", len(description.Projects) == 0)
+	for _, project := range description.Projects {
+		teams := make([]string, len(project.Teams))
+		for i, team := range project.Teams {
+			teams[i] = team.Slug
+		}
+		d.Row(1, project.Slug, project.Platform, strings.Join(teams, ", "))
+	}
+	return d.Flush()
+}
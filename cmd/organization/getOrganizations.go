@@ -1,67 +1,48 @@
 package organization
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+	"context"
 	"os"
 
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
 	"github.com/spf13/cobra"
 )
 
-// API endpoint to list organizations
-const apiEndpoint = "http://localhost:8000/api/0/organizations/"
-
-// GetOrganizationsCmd represents the getOrganizations command
-var GetOrganizationsCmd = &cobra.Command{
-	Use:   "getOrganizations",
-	Short: "List all organizations",
-	Long:  `Retrieve and display a list of all organizations from the GlitchTip API.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		apiToken := os.Getenv("GLITCHTIP_API_TOKEN")
-		if apiToken == "" {
-			fmt.Println("Error: GLITCHTIP_API_TOKEN environment variable is not set.")
-			return
-		}
-		getOrganizations(apiToken)
-	},
-}
-
-func getOrganizations(apiToken string) {
-	// Create an HTTP request
-	req, err := http.NewRequest("GET", apiEndpoint, nil)
-	if err != nil {
-		fmt.Printf("Error creating request: %v\n", err)
-		return
-	}
-
-	req.Header.Add("Authorization", "Bearer "+apiToken)
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		fmt.Printf("Error fetching organizations: %v\n", err)
-		return
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		fmt.Printf("Error: received status code %d\n", resp.StatusCode)
-		return
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Printf("Error reading response body: %v\n", err)
-		return
-	}
-
-	var organizations []Organization
-	err = json.Unmarshal(body, &organizations)
-	if err != nil {
-		fmt.Printf("Error parsing JSON response: %v\n", err)
-		return
+// NewGetCmd creates the command that lists organizations
+func NewGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "organizations",
+		Aliases: []string{"organization", "orgs", "org"},
+		Short:   "List all organizations",
+		Long:    `Retrieve and display a list of all organizations from the GlitchTip API.`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			organizations, err := common.FetchWithSpinner("Fetching organizations...", func() ([]glitchtip.Organization, error) {
+				return client.ListOrganizations(context.Background(), opts)
+			})
+			if err != nil {
+				return err
+			}
+			return organizationPrinter.PrintList(os.Stdout, format, organizations)
+		},
 	}
 
-	printOrganizationsTable(organizations)
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
 }
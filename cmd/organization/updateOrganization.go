@@ -0,0 +1,137 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewUpdateCmd creates the command that changes the settings of an
+// organization
+func NewUpdateCmd() *cobra.Command {
+	var flags updateFlags
+	var orgFlag string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:     "organization [organization_slug]",
+		Aliases: []string{"organizations", "orgs", "org"},
+		Short:   "Change the settings of an organization",
+		Long: `Change the settings of an organization. Only the given settings are sent, and the changed fields
+are printed with their old and new values. Use --dry-run to see the changes without making them.
+
+The organization is given as an argument or with --org, and defaults to the one of the current
+context.`,
+		Example: `  glitchtipctl update org my-org --name "My Org" --require-2fa
+  glitchtipctl update org --org my-org --open-membership
+  glitchtipctl update org --accepting-events=false --dry-run`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			if !flags.changed(cmd) {
+				return common.Validationf("nothing to update, give at least one setting such as --name")
+			}
+			if len(args) > 0 {
+				if orgFlag != "" && orgFlag != args[0] {
+					return common.Validationf("give the organization either as an argument or with --org")
+				}
+				orgFlag = args[0]
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			before, err := common.FetchWithSpinner("Fetching organization...", func() (*glitchtip.Organization, error) {
+				return client.GetOrganization(ctx, orgSlug)
+			})
+			if err != nil {
+				return err
+			}
+			payload, after := flags.request(cmd, *before)
+			if dryRun || payload == (glitchtip.OrganizationUpdateRequest{}) {
+				return organizationPrinter.PrintUpdate(os.Stdout, format, Fields, *before, after)
+			}
+
+			updated, err := common.FetchWithSpinner("Updating organization...", func() (*glitchtip.Organization, error) {
+				return client.UpdateOrganization(ctx, orgSlug, payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Organization %s updated", updated.Slug)
+			return organizationPrinter.PrintUpdate(os.Stdout, format, Fields, *before, *updated)
+		},
+	}
+
+	flags.add(cmd)
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes without making them")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// updateFlags are the organization settings that can be changed
+type updateFlags struct {
+	name            string
+	require2FA      bool
+	openMembership  bool
+	acceptingEvents bool
+}
+
+func (f *updateFlags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.name, "name", "n", "", "New name of the organization")
+	cmd.Flags().BoolVar(&f.require2FA, "require-2fa", false, "Require members to use two-factor authentication")
+	cmd.Flags().BoolVar(&f.openMembership, "open-membership", false, "Let members join any team without an invitation")
+	cmd.Flags().BoolVar(&f.acceptingEvents, "accepting-events", false, "Accept events sent to the projects of the organization")
+}
+
+// changed reports whether any setting was given
+func (f *updateFlags) changed(cmd *cobra.Command) bool {
+	for _, name := range []string{"name", "require-2fa", "open-membership", "accepting-events"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// request returns the payload with the settings that differ from org, and
+// the organization as it looks after the update
+func (f *updateFlags) request(cmd *cobra.Command, org glitchtip.Organization) (glitchtip.OrganizationUpdateRequest, glitchtip.Organization) {
+	var payload glitchtip.OrganizationUpdateRequest
+	if cmd.Flags().Changed("name") && f.name != org.Name {
+		payload.Name, org.Name = &f.name, f.name
+	}
+	if cmd.Flags().Changed("require-2fa") && f.require2FA != org.Require2FA {
+		payload.Require2FA, org.Require2FA = &f.require2FA, f.require2FA
+	}
+	if cmd.Flags().Changed("open-membership") && f.openMembership != org.OpenMembership {
+		payload.OpenMembership, org.OpenMembership = &f.openMembership, f.openMembership
+	}
+	if cmd.Flags().Changed("accepting-events") && f.acceptingEvents != org.IsAcceptingEvents {
+		payload.IsAcceptingEvents, org.IsAcceptingEvents = &f.acceptingEvents, f.acceptingEvents
+	}
+	return payload, org
+}
+
+// Fields are the settings of an organization that update and apply
+// compare
+var Fields = []common.Column[glitchtip.Organization]{
+	{Header: "name", Value: func(org glitchtip.Organization) string { return org.Name }},
+	{Header: "require-2fa", Value: func(org glitchtip.Organization) string { return fmt.Sprintf("%t", org.Require2FA) }},
+	{Header: "open-membership", Value: func(org glitchtip.Organization) string { return fmt.Sprintf("%t", org.OpenMembership) }},
+	{Header: "accepting-events", Value: func(org glitchtip.Organization) string { return fmt.Sprintf("%t", org.IsAcceptingEvents) }},
+}
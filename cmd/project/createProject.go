@@ -0,0 +1,112 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// Predefined list of valid platforms
+var validPlatforms = []string{
+	"python",
+	"react",
+	"django",
+	"flutter",
+	"react-native",
+	"c",
+	"javascript",
+	"node",
+}
+
+// NewCreateCmd creates the command that creates a project
+func NewCreateCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:     "project",
+		Aliases: []string{"proj"},
+		Short:   "Create a new project in GlitchTip",
+		Long:    `Use this command to create a new project within a team and organization in GlitchTip by providing a name, slug, team slug, and platform.`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Get flag values
+			name, _ := cmd.Flags().GetString("name")
+			slug, _ := cmd.Flags().GetString("slug")
+			teamSlug, _ := cmd.Flags().GetString("team")
+			platform, _ := cmd.Flags().GetString("platform")
+
+			// Validate platform
+			if !isValidPlatform(platform) {
+				return common.Validationf("'%s' is not a valid platform. Valid platforms are: %v", platform, validPlatforms)
+			}
+
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+
+			if name == "" || slug == "" || teamSlug == "" || orgSlug == "" || platform == "" {
+				return common.Validationf("name, slug, team, organization, and platform must be provided")
+			}
+
+			// Create the project
+			ctx := context.Background()
+			_, err = client.CreateProject(ctx, orgSlug, teamSlug, glitchtip.ProjectCreateRequest{
+				Name:     name,
+				Slug:     slug,
+				Platform: platform,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create project: %w", err)
+			}
+			common.Infof("Project created successfully!")
+
+			// List the projects after creation
+			return listProjects(ctx, client, orgSlug)
+		},
+	}
+
+	// Define the flags
+	cmd.Flags().StringP("name", "n", "", "Name of the project (required)")
+	cmd.Flags().StringP("slug", "s", "", "Slug for the project (required)")
+	cmd.Flags().StringP("team", "t", "", "Slug of the team (required)")
+	common.AddOrgFlagP(cmd, &orgFlag)
+	cmd.Flags().StringP("platform", "p", "", "Platform of the project e.g. python, React, Javascript, node, C#, or Flutter (required)")
+
+	// Mark flags as required
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("slug")
+	cmd.MarkFlagRequired("team")
+	cmd.MarkFlagRequired("platform")
+	return cmd
+}
+
+// isValidPlatform checks if the given platform is valid
+func isValidPlatform(platform string) bool {
+	for _, p := range validPlatforms {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// listProjects lists all projects for a given organization
+func listProjects(ctx context.Context, client *glitchtip.Client, orgSlug string) error {
+	projects, err := client.ListOrganizationProjects(ctx, orgSlug, nil)
+	if err != nil {
+		return fmt.Errorf("error fetching projects: %w", err)
+	}
+
+	// Print the list of projects
+	return projectPrinter.PrintList(os.Stdout, "table", projects)
+}
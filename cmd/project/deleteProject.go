@@ -0,0 +1,61 @@
+package project
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the command that deletes a project
+func NewDeleteCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:     "project <project_slug>",
+		Aliases: []string{"proj"},
+		Short:   "Delete a project with all of its issues and events",
+		Long: `Delete a project with all of its issues and events.
+
+You are asked to type the project slug again to confirm, unless --yes is given. Use --dry-run to
+see the project and the teams it belongs to.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+			projectSlug := args[0]
+
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				project, err := client.GetProject(ctx, orgSlug, projectSlug)
+				if err != nil {
+					return nil, err
+				}
+				teamSlugs := make([]string, len(project.Teams))
+				for i, team := range project.Teams {
+					teamSlugs[i] = team.Slug
+				}
+				return &common.DeletionPlan{
+					Kind:  "project",
+					Name:  project.Slug,
+					Title: project.Name,
+					Details: []string{
+						"all issues and events of the project",
+						common.Summarize("team", "teams", teamSlugs),
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteProject(ctx, orgSlug, projectSlug)
+			})
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,169 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/cmd/alert"
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// statsDays is the number of days of event volume shown by describe
+const statsDays = 14
+
+// projectDescription is everything describe shows about a project
+type projectDescription struct {
+	Project      *glitchtip.Project
+	Keys         []glitchtip.ProjectKey
+	Environments []glitchtip.Environment
+	Alerts       []glitchtip.ProjectAlert
+	Unresolved   int
+	SeenToday    int
+	// Stats is nil when the server does not provide event statistics
+	Stats *glitchtip.EventStats
+}
+
+// NewDescribeCmd creates the command that shows a project in detail
+func NewDescribeCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:     "project <organization_slug>/<project_slug>",
+		Aliases: []string{"projects", "proj"},
+		Short:   "Show a project with its keys, environments, alert rules and recent activity",
+		Long: `Show a project with its platform, teams, client keys (DSNs), environments and alert rules, the number
+of unresolved issues and the event volume of the last 14 days.
+
+The project can be given as <organization>/<project>, or as a bare slug in the organization of
+--org or the current context.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, projectSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			description, err := common.FetchWithSpinner("Fetching project...", func() (*projectDescription, error) {
+				return describeProject(context.Background(), client, orgSlug, projectSlug)
+			})
+			if err != nil {
+				return err
+			}
+			return printProjectDescription(description, orgSlug)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
+
+// describeProject fetches the project and its related objects
+func describeProject(ctx context.Context, client *glitchtip.Client, orgSlug, projectSlug string) (*projectDescription, error) {
+	project, err := client.GetProject(ctx, orgSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	description := &projectDescription{Project: project}
+
+	if description.Keys, err = client.ListProjectKeys(ctx, orgSlug, projectSlug); err != nil {
+		return nil, err
+	}
+	if description.Environments, err = client.ListProjectEnvironments(ctx, orgSlug, projectSlug); err != nil {
+		return nil, err
+	}
+	if description.Alerts, err = client.ListProjectAlerts(ctx, orgSlug, projectSlug); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	filter := &glitchtip.IssueFilter{Query: "is:unresolved", ProjectIDs: []glitchtip.ID{project.ID}}
+	if description.Unresolved, err = client.CountIssues(ctx, orgSlug, filter); err != nil {
+		return nil, err
+	}
+	filter.Start = now.Add(-24 * time.Hour)
+	if description.SeenToday, err = client.CountIssues(ctx, orgSlug, filter); err != nil {
+		return nil, err
+	}
+
+	// Older GlitchTip versions have no stats endpoint
+	description.Stats, err = client.GetEventStats(ctx, orgSlug, glitchtip.StatsQuery{
+		Interval:   "1d",
+		ProjectIDs: []glitchtip.ID{project.ID},
+		Start:      now.AddDate(0, 0, -statsDays).Truncate(24 * time.Hour),
+		End:        now,
+	})
+	if err != nil && !glitchtip.IsNotFound(err) {
+		return nil, err
+	}
+	return description, nil
+}
+
+// printProjectDescription writes the project view to stdout
+func printProjectDescription(description *projectDescription, orgSlug string) error {
+	project := description.Project
+	d := common.NewDescriptionWriter(os.Stdout)
+
+	teams := make([]string, len(project.Teams))
+	for i, team := range project.Teams {
+		teams[i] = team.Slug
+	}
+	d.Field(0, "Name", project.Name)
+	d.Field(0, "Slug", project.Slug)
+	d.Field(0, "ID", project.ID)
+	d.Field(0, "Organization", orgSlug)
+	d.Field(0, "Platform", project.Platform)
+	d.Field(0, "Teams", strings.Join(teams, ", "))
+	d.Field(0, "Created", project.DateCreated)
+	d.Field(0, "First Event", project.FirstEvent)
+	d.Field(0, "Scrub IP Addresses", project.ScrubIPAddresses)
+	d.Field(0, "Event Throttle Rate", fmt.Sprintf("%d%%", project.EventThrottleRate))
+
+	d.Section(0, "Keys", len(description.Keys) == 0)
+	for _, key := range description.Keys {
+		d.Row(1, key.Name, activeText(key.IsActive), key.DSN.Public)
+	}
+
+	environments := make([]string, 0, len(description.Environments))
+	for _, environment := range description.Environments {
+		if !environment.IsHidden {
+			environments = append(environments, environment.Name)
+		}
+	}
+	d.Field(0, "Environments", strings.Join(environments, ", "))
+
+	d.Section(0, "Alert Rules", len(description.Alerts) == 0)
+	for _, rule := range description.Alerts {
+		d.Row(1, alert.DisplayName(rule), alert.Condition(rule), alert.Recipients(rule))
+	}
+
+	d.Section(0, "Issues", false)
+	d.Field(1, "Unresolved", description.Unresolved)
+	d.Field(1, "Seen in the last 24h", description.SeenToday)
+
+	if description.Stats == nil {
+		d.Field(0, "Events", "<unavailable>")
+	} else {
+		series := description.Stats.Series(glitchtip.StatsFieldQuantity)
+		d.Section(0, "Events", false)
+		d.Field(1, fmt.Sprintf("Last %d days", statsDays), fmt.Sprintf("%d  %s",
+			description.Stats.Total(glitchtip.StatsFieldQuantity), common.Sparkline(series)))
+	}
+	return d.Flush()
+}
+
+// activeText labels a client key as active or disabled
+func activeText(active bool) string {
+	if active {
+		return "active"
+	}
+	return "disabled"
+}
@@ -0,0 +1,149 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewUpdateCmd creates the command that changes the settings of a project
+func NewUpdateCmd() *cobra.Command {
+	var orgFlag string
+	var flags updateFlags
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:     "project <project>",
+		Aliases: []string{"projects", "proj"},
+		Short:   "Change the settings of a project",
+		Long: `Change the settings of a project. Only the given settings are sent, and the changed fields are
+printed with their old and new values. Use --dry-run to see the changes without making them.
+
+The project can be given as <organization>/<project>, or as a bare slug in the organization of --org
+or the current context.`,
+		Example: `  glitchtipctl update project my-org/web --name "Web shop" --platform javascript
+  glitchtipctl update project web --event-throttle-rate 50 --scrub-ip-addresses`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			if err := flags.validate(cmd); err != nil {
+				return err
+			}
+			orgSlug, projectSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			before, err := common.FetchWithSpinner("Fetching project...", func() (*glitchtip.Project, error) {
+				return client.GetProject(ctx, orgSlug, projectSlug)
+			})
+			if err != nil {
+				return err
+			}
+			payload, after := flags.request(cmd, *before)
+			if dryRun || payload == (glitchtip.ProjectUpdateRequest{}) {
+				return projectPrinter.PrintUpdate(os.Stdout, format, Fields, *before, after)
+			}
+
+			updated, err := common.FetchWithSpinner("Updating project...", func() (*glitchtip.Project, error) {
+				return client.UpdateProject(ctx, orgSlug, projectSlug, payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Project %s updated", updated.Slug)
+			return projectPrinter.PrintUpdate(os.Stdout, format, Fields, *before, *updated)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	flags.add(cmd)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes without making them")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// updateFlags are the project settings that can be changed
+type updateFlags struct {
+	name              string
+	slug              string
+	platform          string
+	eventThrottleRate int
+	scrubIPAddresses  bool
+}
+
+func (f *updateFlags) add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.name, "name", "n", "", "New name of the project")
+	cmd.Flags().StringVarP(&f.slug, "slug", "s", "", "New slug of the project")
+	cmd.Flags().StringVarP(&f.platform, "platform", "p", "", "Platform of the project e.g. python, javascript or node")
+	cmd.Flags().IntVar(&f.eventThrottleRate, "event-throttle-rate", 0, "Percentage of events to drop, from 0 to 100")
+	cmd.Flags().BoolVar(&f.scrubIPAddresses, "scrub-ip-addresses", false, "Remove IP addresses from incoming events")
+}
+
+// validate checks that at least one setting was given and that the
+// given settings are valid
+func (f *updateFlags) validate(cmd *cobra.Command) error {
+	changed := false
+	for _, name := range []string{"name", "slug", "platform", "event-throttle-rate", "scrub-ip-addresses"} {
+		changed = changed || cmd.Flags().Changed(name)
+	}
+	if !changed {
+		return common.Validationf("nothing to update, give at least one setting such as --name or --platform")
+	}
+	if cmd.Flags().Changed("name") && f.name == "" {
+		return common.Validationf("the name of a project cannot be empty")
+	}
+	if cmd.Flags().Changed("slug") && (f.slug == "" || f.slug != glitchtip.Slugify(f.slug)) {
+		return common.Validationf("%q is not a valid slug, use lowercase letters, digits and dashes", f.slug)
+	}
+	if cmd.Flags().Changed("platform") && !isValidPlatform(f.platform) {
+		return common.Validationf("'%s' is not a valid platform. Valid platforms are: %v", f.platform, validPlatforms)
+	}
+	if f.eventThrottleRate < 0 || f.eventThrottleRate > 100 {
+		return common.Validationf("--event-throttle-rate must be between 0 and 100, got %d", f.eventThrottleRate)
+	}
+	return nil
+}
+
+// request returns the payload with the settings that differ from project,
+// and the project as it looks after the update
+func (f *updateFlags) request(cmd *cobra.Command, project glitchtip.Project) (glitchtip.ProjectUpdateRequest, glitchtip.Project) {
+	var payload glitchtip.ProjectUpdateRequest
+	if cmd.Flags().Changed("name") && f.name != project.Name {
+		payload.Name, project.Name = &f.name, f.name
+	}
+	if cmd.Flags().Changed("slug") && f.slug != project.Slug {
+		payload.Slug, project.Slug = &f.slug, f.slug
+	}
+	if cmd.Flags().Changed("platform") && f.platform != project.Platform {
+		payload.Platform, project.Platform = &f.platform, f.platform
+	}
+	if cmd.Flags().Changed("event-throttle-rate") && f.eventThrottleRate != project.EventThrottleRate {
+		payload.EventThrottleRate, project.EventThrottleRate = &f.eventThrottleRate, f.eventThrottleRate
+	}
+	if cmd.Flags().Changed("scrub-ip-addresses") && f.scrubIPAddresses != project.ScrubIPAddresses {
+		payload.ScrubIPAddresses, project.ScrubIPAddresses = &f.scrubIPAddresses, f.scrubIPAddresses
+	}
+	return payload, project
+}
+
+// Fields are the settings of a project that update and apply compare
+var Fields = []common.Column[glitchtip.Project]{
+	{Header: "name", Value: func(project glitchtip.Project) string { return project.Name }},
+	{Header: "slug", Value: func(project glitchtip.Project) string { return project.Slug }},
+	{Header: "platform", Value: func(project glitchtip.Project) string { return project.Platform }},
+	{Header: "event-throttle-rate", Value: func(project glitchtip.Project) string { return fmt.Sprintf("%d", project.EventThrottleRate) }},
+	{Header: "scrub-ip-addresses", Value: func(project glitchtip.Project) string { return fmt.Sprintf("%t", project.ScrubIPAddresses) }},
+}
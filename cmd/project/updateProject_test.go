@@ -0,0 +1,63 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// parseUpdateFlags parses args into the update flags of a new command
+func parseUpdateFlags(t *testing.T, args ...string) (*cobra.Command, *updateFlags) {
+	t.Helper()
+	var flags updateFlags
+	cmd := &cobra.Command{}
+	flags.add(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd, &flags
+}
+
+func TestUpdateRequest(t *testing.T) {
+	project := glitchtip.Project{Name: "Web", Slug: "web", Platform: "python", EventThrottleRate: 10, ScrubIPAddresses: true}
+
+	// Settings equal to the current ones are left out of the payload
+	cmd, flags := parseUpdateFlags(t, "--name", "Web", "--platform", "node", "--event-throttle-rate", "0", "--scrub-ip-addresses=false")
+	if err := flags.validate(cmd); err != nil {
+		t.Fatal(err)
+	}
+	payload, after := flags.request(cmd, project)
+	if payload.Name != nil || payload.Slug != nil || *payload.Platform != "node" || *payload.EventThrottleRate != 0 || *payload.ScrubIPAddresses {
+		t.Errorf("payload = %+v", payload)
+	}
+	want := glitchtip.Project{Name: "Web", Slug: "web", Platform: "node"}
+	if after.Name != want.Name || after.Platform != want.Platform || after.EventThrottleRate != 0 || after.ScrubIPAddresses {
+		t.Errorf("after = %+v, want %+v", after, want)
+	}
+	changes := common.Diff(Fields, project, after)
+	if len(changes) != 3 || changes[0].Field != "platform" || changes[0].Before != "python" || changes[0].After != "node" {
+		t.Errorf("changes = %+v", changes)
+	}
+
+	cmd, flags = parseUpdateFlags(t, "--name", "Web")
+	if payload, _ := flags.request(cmd, project); payload != (glitchtip.ProjectUpdateRequest{}) {
+		t.Errorf("payload without changes = %+v", payload)
+	}
+}
+
+func TestUpdateValidate(t *testing.T) {
+	for _, args := range [][]string{
+		{},
+		{"--name", ""},
+		{"--slug", "Web Shop"},
+		{"--platform", "cobol"},
+		{"--event-throttle-rate", "101"},
+	} {
+		cmd, flags := parseUpdateFlags(t, args...)
+		if err := flags.validate(cmd); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("validate(%q) = %v, want a validation error", args, err)
+		}
+	}
+}
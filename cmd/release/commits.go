@@ -0,0 +1,152 @@
+package release
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// setCommitsFlags holds the flags of the set-commits command
+type setCommitsFlags struct {
+	org           string
+	auto          bool
+	local         bool
+	commits       []string
+	ignoreMissing bool
+	initialDepth  int
+}
+
+// newSetCommitsCmd creates the releases set-commits command
+func newSetCommitsCmd() *cobra.Command {
+	var flags setCommitsFlags
+
+	cmd := &cobra.Command{
+		Use:   "set-commits <version>",
+		Short: "Associate commits with a release",
+		Long: `Associate commits with a release, either from the git repository in the current directory
+(--auto or --local) or from explicit --commit REPO@FROM..TO ranges.
+
+With --auto or --local the commits since the ref of the previous release are sent, or the last
+--initial-depth commits when there is no previous release. GlitchTip has no repository integrations,
+so --auto reads the local repository as well. The release's ref is set to the newest commit.`,
+		Example: `  glitchtipctl releases set-commits 1.4.0 --auto
+  glitchtipctl releases set-commits 1.4.0 --commit "my-org/web@1a2b3c..4d5e6f"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !flags.auto && !flags.local && len(flags.commits) == 0 {
+				return common.Validationf("give --auto, --local or at least one --commit")
+			}
+			orgSlug, err := common.ResolveOrganization(flags.org)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+			version := args[0]
+			ctx := context.Background()
+
+			var payload glitchtip.ReleaseUpdate
+			if len(flags.commits) > 0 {
+				for _, spec := range flags.commits {
+					ref, err := parseCommitSpec(spec)
+					if err != nil {
+						return err
+					}
+					payload.Refs = append(payload.Refs, ref)
+				}
+				payload.Ref = payload.Refs[len(payload.Refs)-1].Commit
+			} else if payload, err = localCommits(ctx, client, orgSlug, version, flags); err != nil {
+				return err
+			}
+
+			_, err = common.FetchWithSpinner("Setting commits...", func() (*glitchtip.Release, error) {
+				return client.UpdateRelease(ctx, orgSlug, version, payload)
+			})
+			if err != nil {
+				return err
+			}
+			if len(payload.Commits) > 0 {
+				common.Infof("Set %d commits on release %s (ref %s)", len(payload.Commits), version, payload.Ref)
+			} else {
+				common.Infof("Set ref %s on release %s", payload.Ref, version)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &flags.org)
+	cmd.Flags().BoolVar(&flags.auto, "auto", false, "Use the commits of the git repository in the current directory")
+	cmd.Flags().BoolVar(&flags.local, "local", false, "Same as --auto")
+	cmd.Flags().StringArrayVarP(&flags.commits, "commit", "c", nil, "Commit range as REPO@REV or REPO@FROM..TO (repeatable)")
+	cmd.Flags().BoolVar(&flags.ignoreMissing, "ignore-missing", false, "Fall back to --initial-depth when the previous release's ref is not in the local history")
+	cmd.Flags().IntVar(&flags.initialDepth, "initial-depth", 20, "Number of commits to send when there is no previous release")
+	cmd.MarkFlagsMutuallyExclusive("auto", "commit")
+	cmd.MarkFlagsMutuallyExclusive("local", "commit")
+	return cmd
+}
+
+// localCommits collects the commits of the local repository since the
+// previous release
+func localCommits(ctx context.Context, client *glitchtip.Client, orgSlug, version string, flags setCommitsFlags) (glitchtip.ReleaseUpdate, error) {
+	repository, err := localRepository()
+	if err != nil {
+		return glitchtip.ReleaseUpdate{}, err
+	}
+	head, err := runGit("rev-parse", "HEAD")
+	if err != nil {
+		return glitchtip.ReleaseUpdate{}, err
+	}
+
+	previous, err := previousRef(ctx, client, orgSlug, version)
+	if err != nil {
+		return glitchtip.ReleaseUpdate{}, err
+	}
+	if previous != "" {
+		if _, err := runGit("cat-file", "-e", previous+"^{commit}"); err != nil {
+			if !flags.ignoreMissing {
+				return glitchtip.ReleaseUpdate{}, common.Validationf("the previous release's ref %s is not in the local history, fetch more history or pass --ignore-missing", previous)
+			}
+			previous = ""
+		}
+	}
+
+	logArgs := []string{"log", gitLogFormat}
+	if previous != "" {
+		logArgs = append(logArgs, previous+"..HEAD")
+	} else {
+		logArgs = append(logArgs, "-n", strconv.Itoa(flags.initialDepth), "HEAD")
+	}
+	output, err := runGit(logArgs...)
+	if err != nil {
+		return glitchtip.ReleaseUpdate{}, err
+	}
+
+	return glitchtip.ReleaseUpdate{
+		Ref:     head,
+		Commits: parseGitLog(output, repository),
+		Refs:    []glitchtip.ReleaseRef{{Repository: repository, Commit: head, PreviousCommit: previous}},
+	}, nil
+}
+
+// previousRef returns the ref of the newest release older than version
+// that has one
+func previousRef(ctx context.Context, client *glitchtip.Client, orgSlug, version string) (string, error) {
+	it := client.IterReleases(ctx, orgSlug, nil)
+	seen := false
+	for it.Next() {
+		release := it.Item()
+		if release.Version == version {
+			seen = true
+			continue
+		}
+		if seen && release.Ref != "" {
+			return release.Ref, nil
+		}
+	}
+	return "", it.Err()
+}
@@ -0,0 +1,67 @@
+package release
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the "delete release" command
+func NewDeleteCmd() *cobra.Command {
+	cmd := newDeleteCmd()
+	cmd.Use = "release <version>"
+	cmd.Aliases = []string{"releases"}
+	return cmd
+}
+
+// newDeleteCmd creates the releases delete command
+func newDeleteCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "delete <version>",
+		Short: "Delete a release",
+		Long: `Delete a release with its files and deploys. Events keep their release tag.
+
+You are asked to type the version again to confirm, unless --yes is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				release, err := client.GetRelease(ctx, orgSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				slugs := make([]string, len(release.Projects))
+				for i, project := range release.Projects {
+					slugs[i] = project.Slug
+				}
+				return &common.DeletionPlan{
+					Kind:  "release",
+					Name:  release.Version,
+					Title: orgSlug + "@" + release.Version,
+					Details: []string{
+						common.Summarize("project", "projects", slugs),
+						fmt.Sprintf("deploys: %d", release.DeployCount),
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteRelease(ctx, orgSlug, args[0])
+			})
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,146 @@
+package release
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// deployFlags holds the flags of the deploys command
+type deployFlags struct {
+	org         string
+	environment string
+	name        string
+	url         string
+	started     string
+	finished    string
+	seconds     int
+}
+
+// newDeploysCmd creates the releases deploys command. As in sentry-cli the
+// version comes before the action, "deploys <version> new", but the
+// other order is accepted as well.
+func newDeploysCmd() *cobra.Command {
+	var flags deployFlags
+
+	cmd := &cobra.Command{
+		Use:   "deploys <version> new|list",
+		Short: "Record or list the deploys of a release",
+		Long: `Record a deploy of a release to an environment with "new", or list the deploys of a release with
+"list".`,
+		Example: `  glitchtipctl releases deploys 1.4.0 new --env production
+  glitchtipctl releases deploys 1.4.0 new -e staging --name "canary" --time 95
+  glitchtipctl releases deploys 1.4.0 list`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, action := args[0], args[1]
+			if version == "new" || version == "list" {
+				version, action = action, version
+			}
+			switch action {
+			case "new":
+				return runNewDeploy(cmd, version, flags)
+			case "list":
+				return runListDeploys(cmd, version, flags)
+			default:
+				return common.Validationf("unknown deploys action %q, expected new or list", action)
+			}
+		},
+	}
+
+	common.AddOrgFlag(cmd, &flags.org)
+	cmd.Flags().StringVarP(&flags.environment, "env", "e", "", "Environment the release was deployed to (required for new)")
+	cmd.Flags().StringVarP(&flags.name, "name", "n", "", "Name of the deploy")
+	cmd.Flags().StringVarP(&flags.url, "url", "u", "", "URL of the deploy, e.g. the pipeline run")
+	cmd.Flags().StringVar(&flags.started, "started", "", "Start time as a timestamp or a duration ago")
+	cmd.Flags().StringVar(&flags.finished, "finished", "", "Finish time as a timestamp or a duration ago (defaults to now)")
+	cmd.Flags().IntVarP(&flags.seconds, "time", "t", 0, "Duration of the deploy in seconds, ending now")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// runNewDeploy records a deploy
+func runNewDeploy(cmd *cobra.Command, version string, flags deployFlags) error {
+	if flags.environment == "" {
+		return common.Validationf("--env is required to record a deploy")
+	}
+	if flags.seconds != 0 && (flags.started != "" || flags.finished != "") {
+		return common.Validationf("--time cannot be combined with --started or --finished")
+	}
+	format, err := common.OutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	orgSlug, err := common.ResolveOrganization(flags.org)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	finished := now.UTC()
+	payload := glitchtip.DeployCreateRequest{
+		Environment:  flags.environment,
+		Name:         flags.name,
+		URL:          flags.url,
+		DateFinished: &finished,
+	}
+	if flags.seconds > 0 {
+		started := finished.Add(-time.Duration(flags.seconds) * time.Second)
+		payload.DateStarted = &started
+	}
+	if flags.started != "" {
+		started, err := common.ParseTime(flags.started, now)
+		if err != nil {
+			return err
+		}
+		started = started.UTC()
+		payload.DateStarted = &started
+	}
+	if flags.finished != "" {
+		if finished, err = common.ParseTime(flags.finished, now); err != nil {
+			return err
+		}
+		finished = finished.UTC()
+	}
+
+	client, err := common.NewClient()
+	if err != nil {
+		return err
+	}
+	deploy, err := common.FetchWithSpinner("Recording deploy...", func() (*glitchtip.Deploy, error) {
+		return client.CreateDeploy(context.Background(), orgSlug, version, payload)
+	})
+	if err != nil {
+		return err
+	}
+	common.Infof("Created deploy of %s to %s", version, deploy.Environment)
+	return deployPrinter.PrintObject(os.Stdout, format, *deploy)
+}
+
+// runListDeploys lists the deploys of a release
+func runListDeploys(cmd *cobra.Command, version string, flags deployFlags) error {
+	format, err := common.OutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	orgSlug, err := common.ResolveOrganization(flags.org)
+	if err != nil {
+		return err
+	}
+	client, err := common.NewClient()
+	if err != nil {
+		return err
+	}
+
+	deploys, err := common.FetchWithSpinner("Fetching deploys...", func() ([]glitchtip.Deploy, error) {
+		return client.ListDeploys(context.Background(), orgSlug, version)
+	})
+	if err != nil {
+		return err
+	}
+	return deployPrinter.PrintList(os.Stdout, format, deploys)
+}
@@ -0,0 +1,60 @@
+package release
+
+import (
+	"context"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newFinalizeCmd creates the releases finalize command
+func newFinalizeCmd() *cobra.Command {
+	var orgFlag, released, url string
+
+	cmd := &cobra.Command{
+		Use:   "finalize <version>",
+		Short: "Mark a release as released",
+		Long: `Mark a release as released. Issues resolved "in the next release" are resolved by the first
+finalized release after them.`,
+		Example: `  glitchtipctl releases finalize 1.4.0 --org my-org
+  glitchtipctl releases finalize 1.4.0 --released 2024-05-01T08:00:00Z`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			now := time.Now().Truncate(time.Second)
+			releasedAt := now
+			if released != "" {
+				if releasedAt, err = common.ParseTime(released, now); err != nil {
+					return err
+				}
+			}
+			releasedAt = releasedAt.UTC()
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			_, err = common.FetchWithSpinner("Finalizing release...", func() (*glitchtip.Release, error) {
+				return client.UpdateRelease(context.Background(), orgSlug, args[0], glitchtip.ReleaseUpdate{
+					DateReleased: &releasedAt,
+					URL:          url,
+				})
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Finalized release %s", args[0])
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringVar(&released, "released", "", "Release time as a timestamp or a duration ago (defaults to now)")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the release, e.g. its changelog")
+	return cmd
+}
@@ -0,0 +1,100 @@
+package release
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// gitLogFormat separates the commit fields with unit separators and the
+// commits with record separators, neither of which occur in messages
+const gitLogFormat = "--format=%H%x1f%an%x1f%ae%x1f%aI%x1f%s%x1e"
+
+// runGit runs a git command in the current directory and returns its
+// trimmed output
+func runGit(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), message)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// parseGitLog parses the output of git log with gitLogFormat
+func parseGitLog(output, repository string) []glitchtip.ReleaseCommit {
+	var commits []glitchtip.ReleaseCommit
+	for _, record := range strings.Split(output, "\x1e") {
+		fields := strings.Split(strings.TrimSpace(record), "\x1f")
+		if len(fields) != 5 {
+			continue
+		}
+		commits = append(commits, glitchtip.ReleaseCommit{
+			ID:          fields[0],
+			Repository:  repository,
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			Timestamp:   fields[3],
+			Message:     fields[4],
+		})
+	}
+	return commits
+}
+
+// repositoryName derives "owner/repo" from a remote URL such as
+// git@github.com:owner/repo.git or https://github.com/owner/repo
+func repositoryName(remote string) string {
+	remote = strings.TrimSuffix(strings.TrimSpace(remote), "/")
+	remote = strings.TrimSuffix(remote, ".git")
+	if i := strings.Index(remote, "://"); i >= 0 {
+		remote = remote[i+3:]
+	} else if i := strings.Index(remote, ":"); i >= 0 {
+		remote = "/" + remote[i+1:]
+	}
+	parts := strings.Split(remote, "/")
+	if len(parts) >= 2 && parts[len(parts)-2] != "" {
+		return parts[len(parts)-2] + "/" + parts[len(parts)-1]
+	}
+	return parts[len(parts)-1]
+}
+
+// localRepository returns the name of the repository in the current
+// directory, taken from its origin remote or else its directory name
+func localRepository() (string, error) {
+	if remote, err := runGit("remote", "get-url", "origin"); err == nil && remote != "" {
+		return repositoryName(remote), nil
+	}
+	top, err := runGit("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(top), nil
+}
+
+// parseCommitSpec parses a --commit value of the form REPO@REV or
+// REPO@FROM..TO, as accepted by sentry-cli
+func parseCommitSpec(spec string) (glitchtip.ReleaseRef, error) {
+	repository, revisions, ok := strings.Cut(spec, "@")
+	if !ok || repository == "" || revisions == "" {
+		return glitchtip.ReleaseRef{}, common.Validationf("--commit %q must be of the form REPO@REV or REPO@FROM..TO", spec)
+	}
+	ref := glitchtip.ReleaseRef{Repository: repository, Commit: revisions}
+	if from, to, ok := strings.Cut(revisions, ".."); ok {
+		if from == "" || to == "" {
+			return glitchtip.ReleaseRef{}, common.Validationf("--commit %q must be of the form REPO@REV or REPO@FROM..TO", spec)
+		}
+		ref.PreviousCommit, ref.Commit = from, to
+	}
+	return ref, nil
+}
@@ -0,0 +1,59 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestParseGitLog(t *testing.T) {
+	output := "abc\x1fAnn\x1fann@example.com\x1f2024-05-01T08:00:00+02:00\x1fFix login\x1e\n" +
+		"def\x1fBob\x1fbob@example.com\x1f2024-04-30T08:00:00+02:00\x1fAdd\x1fseparator\x1e\n"
+	commits := parseGitLog(output, "acme/web")
+	if len(commits) != 1 {
+		t.Fatalf("parseGitLog returned %d commits, want the 1 well-formed one", len(commits))
+	}
+	want := glitchtip.ReleaseCommit{
+		ID:          "abc",
+		Repository:  "acme/web",
+		Message:     "Fix login",
+		AuthorName:  "Ann",
+		AuthorEmail: "ann@example.com",
+		Timestamp:   "2024-05-01T08:00:00+02:00",
+	}
+	if commits[0] != want {
+		t.Errorf("commit = %+v, want %+v", commits[0], want)
+	}
+}
+
+func TestRepositoryName(t *testing.T) {
+	tests := map[string]string{
+		"git@github.com:acme/web.git":       "acme/web",
+		"https://github.com/acme/web":       "acme/web",
+		"https://gitlab.com/group/sub/web/": "sub/web",
+		"ssh://git@example.com:22/acme/web": "acme/web",
+		"web":                               "web",
+	}
+	for remote, want := range tests {
+		if got := repositoryName(remote); got != want {
+			t.Errorf("repositoryName(%q) = %q, want %q", remote, got, want)
+		}
+	}
+}
+
+func TestParseCommitSpec(t *testing.T) {
+	ref, err := parseCommitSpec("acme/web@abc..def")
+	if err != nil || ref != (glitchtip.ReleaseRef{Repository: "acme/web", Commit: "def", PreviousCommit: "abc"}) {
+		t.Errorf("parseCommitSpec with a range = %+v, %v", ref, err)
+	}
+	ref, err = parseCommitSpec("acme/web@def")
+	if err != nil || ref != (glitchtip.ReleaseRef{Repository: "acme/web", Commit: "def"}) {
+		t.Errorf("parseCommitSpec with a revision = %+v, %v", ref, err)
+	}
+	for _, spec := range []string{"acme/web", "@def", "acme/web@", "acme/web@abc.."} {
+		if _, err := parseCommitSpec(spec); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("parseCommitSpec(%q) = %v, want a validation error", spec, err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package release
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// releaseInfo is a release with its deploys
+type releaseInfo struct {
+	Release *glitchtip.Release
+	Deploys []glitchtip.Deploy
+}
+
+// newInfoCmd creates the releases info command
+func newInfoCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "info <version>",
+		Short: "Show a release with its projects and deploys",
+		Long: `Show a release with its projects, commit and new issue counts, and deploys. Other output formats
+than table print the release object of the API. The command fails with exit code 4 when the release
+does not exist, so it can be used to check for a release in scripts.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			info, err := common.FetchWithSpinner("Fetching release...", func() (*releaseInfo, error) {
+				ctx := context.Background()
+				release, err := client.GetRelease(ctx, orgSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				deploys, err := client.ListDeploys(ctx, orgSlug, args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &releaseInfo{Release: release, Deploys: deploys}, nil
+			})
+			if err != nil {
+				return err
+			}
+			if format != "table" && format != "wide" {
+				return releasePrinter.PrintObject(os.Stdout, format, *info.Release)
+			}
+
+			release := info.Release
+			d := common.NewDescriptionWriter(os.Stdout)
+			d.Field(0, "Version", release.Version)
+			d.Field(0, "Organization", orgSlug)
+			d.Field(0, "Projects", projectSlugs(*release))
+			d.Field(0, "Ref", release.Ref)
+			d.Field(0, "URL", release.URL)
+			d.Field(0, "Created", release.DateCreated)
+			d.Field(0, "Released", releasedText(*release))
+			d.Field(0, "Commits", release.CommitCount)
+			d.Field(0, "New Issues", release.NewGroups)
+			d.Section(0, "Deploys", len(info.Deploys) == 0)
+			for _, deploy := range info.Deploys {
+				d.Row(1, deploy.Environment, deploy.Name, deploy.DateFinished)
+			}
+			return d.Flush()
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
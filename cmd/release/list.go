@@ -0,0 +1,71 @@
+package release
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the "get releases" command, the release list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "releases"
+	cmd.Aliases = []string{"release"}
+	return cmd
+}
+
+// newListCmd creates the releases list command
+func newListCmd() *cobra.Command {
+	var orgFlag, project string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the releases of an organization or project",
+		Long:  `List the releases of an organization, newest first. Use --project to only list one project's releases.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			var projects []string
+			if project != "" {
+				projects = []string{project}
+			}
+			orgSlug, projectSlugs, err := resolveProjects(orgFlag, projects)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			releases, err := common.FetchWithSpinner("Fetching releases...", func() ([]glitchtip.Release, error) {
+				if len(projectSlugs) > 0 {
+					return client.ListProjectReleases(context.Background(), orgSlug, projectSlugs[0], opts)
+				}
+				return client.ListReleases(context.Background(), orgSlug, opts)
+			})
+			if err != nil {
+				return err
+			}
+			return releasePrinter.PrintList(os.Stdout, format, releases)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Only list the releases of this project (slug or <organization>/<project>)")
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
+}
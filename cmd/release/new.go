@@ -0,0 +1,78 @@
+package release
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateCmd creates the "create release" command
+func NewCreateCmd() *cobra.Command {
+	cmd := newNewCmd()
+	cmd.Use = "release <version>"
+	cmd.Aliases = []string{"releases"}
+	return cmd
+}
+
+// newNewCmd creates the releases new command
+func newNewCmd() *cobra.Command {
+	var orgFlag, url, ref string
+	var projects []string
+	var finalize bool
+
+	cmd := &cobra.Command{
+		Use:   "new <version>",
+		Short: "Create a release for one or more projects",
+		Long: `Create a release for one or more projects. Creating a version that already exists adds the
+projects to it. Use --finalize to mark the release as released right away.`,
+		Example: `  glitchtipctl releases new 1.4.0 --org my-org -p web -p api
+  glitchtipctl releases new "$(glitchtipctl releases propose-version)" -p my-org/web --finalize`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, projectSlugs, err := resolveProjects(orgFlag, projects)
+			if err != nil {
+				return err
+			}
+			payload := glitchtip.ReleaseCreateRequest{
+				Version:  args[0],
+				Projects: projectSlugs,
+				Ref:      ref,
+				URL:      url,
+			}
+			if finalize {
+				now := time.Now().UTC().Truncate(time.Second)
+				payload.DateReleased = &now
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			release, err := common.FetchWithSpinner("Creating release...", func() (*glitchtip.Release, error) {
+				return client.CreateRelease(context.Background(), orgSlug, payload)
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Created release %s", release.Version)
+			return releasePrinter.PrintObject(os.Stdout, format, *release)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringArrayVarP(&projects, "project", "p", nil, "Project slug, or <organization>/<project> (repeatable, required)")
+	cmd.Flags().BoolVar(&finalize, "finalize", false, "Finalize the release right away")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the release, e.g. its changelog")
+	cmd.Flags().StringVar(&ref, "ref", "", "Commit or tag the release was built from")
+	cmd.MarkFlagRequired("project")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
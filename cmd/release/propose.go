@@ -0,0 +1,25 @@
+package release
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newProposeVersionCmd creates the releases propose-version command
+func newProposeVersionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "propose-version",
+		Short: "Print a release version for the current git commit",
+		Long:  `Print a release version for the current directory, the SHA of the checked out git commit.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := runGit("rev-parse", "HEAD")
+			if err != nil {
+				return err
+			}
+			fmt.Println(version)
+			return nil
+		},
+	}
+}
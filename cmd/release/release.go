@@ -0,0 +1,100 @@
+package release
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// ReleasesCmd groups the commands that manage releases
+var ReleasesCmd = &cobra.Command{
+	Use:     "releases",
+	Aliases: []string{"release"},
+	Short:   "Manage the releases of an organization",
+	Long: `Manage the releases of an organization: create and finalize them, associate commits and record
+deploys. The commands and flags follow sentry-cli, so that pipelines can switch by replacing
+"sentry-cli" with "glitchtipctl" and "-o <org>" with "--org <org>".`,
+}
+
+// releasePrinter prints releases in every output format
+var releasePrinter = common.ResourcePrinter[glitchtip.Release]{
+	Kind: "release",
+	Name: func(release glitchtip.Release) string { return release.Version },
+	Columns: []common.Column[glitchtip.Release]{
+		{Header: "Version", Value: func(release glitchtip.Release) string { return release.Version }},
+		{Header: "Projects", Value: func(release glitchtip.Release) string { return projectSlugs(release) }},
+		{Header: "Released", Value: func(release glitchtip.Release) string { return releasedText(release) }},
+		{Header: "New Issues", Value: func(release glitchtip.Release) string { return fmt.Sprintf("%d", release.NewGroups) }},
+		{Header: "Deploys", Value: func(release glitchtip.Release) string { return fmt.Sprintf("%d", release.DeployCount) }},
+		{Header: "Created", Value: func(release glitchtip.Release) string { return release.DateCreated }},
+		{Header: "Ref", Wide: true, Value: func(release glitchtip.Release) string { return release.Ref }},
+		{Header: "URL", Wide: true, Value: func(release glitchtip.Release) string { return release.URL }},
+	},
+}
+
+// deployPrinter prints deploys in every output format
+var deployPrinter = common.ResourcePrinter[glitchtip.Deploy]{
+	Kind: "deploy",
+	Name: func(deploy glitchtip.Deploy) string { return deploy.ID.String() },
+	Columns: []common.Column[glitchtip.Deploy]{
+		{Header: "Environment", Value: func(deploy glitchtip.Deploy) string { return deploy.Environment }},
+		{Header: "Name", Value: func(deploy glitchtip.Deploy) string { return deploy.Name }},
+		{Header: "Started", Value: func(deploy glitchtip.Deploy) string { return deploy.DateStarted }},
+		{Header: "Finished", Value: func(deploy glitchtip.Deploy) string { return deploy.DateFinished }},
+		{Header: "URL", Wide: true, Value: func(deploy glitchtip.Deploy) string { return deploy.URL }},
+	},
+}
+
+// projectSlugs joins the slugs of the projects of a release
+func projectSlugs(release glitchtip.Release) string {
+	slugs := make([]string, len(release.Projects))
+	for i, project := range release.Projects {
+		slugs[i] = project.Slug
+	}
+	return strings.Join(slugs, ",")
+}
+
+// releasedText shows when a release was finalized
+func releasedText(release glitchtip.Release) string {
+	if release.DateReleased == "" {
+		return "(unreleased)"
+	}
+	return release.DateReleased
+}
+
+// resolveProjects splits --project values, which may be given as
+// <organization>/<project>, into one organization and the project slugs
+func resolveProjects(orgFlag string, projects []string) (string, []string, error) {
+	orgSlug := orgFlag
+	slugs := make([]string, len(projects))
+	for i, project := range projects {
+		projectOrg, slug, err := common.ResolveScopedSlug(project, orgSlug)
+		if err != nil {
+			return "", nil, err
+		}
+		orgSlug, slugs[i] = projectOrg, slug
+	}
+	if orgSlug == "" {
+		var err error
+		if orgSlug, err = common.ResolveOrganization(orgFlag); err != nil {
+			return "", nil, err
+		}
+	}
+	return orgSlug, slugs, nil
+}
+
+func init() {
+	ReleasesCmd.AddCommand(
+		newNewCmd(),
+		newListCmd(),
+		newInfoCmd(),
+		newFinalizeCmd(),
+		newDeleteCmd(),
+		newSetCommitsCmd(),
+		newDeploysCmd(),
+		newProposeVersionCmd(),
+	)
+}
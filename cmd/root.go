@@ -4,9 +4,21 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/nanyte25/glitchtipctl/cmd/organization"
-	"github.com/nanyte25/glitchtipctl/cmd/project"
+	"github.com/nanyte25/glitchtipctl/cmd/alert"
+	"github.com/nanyte25/glitchtipctl/cmd/apply"
+	configcmd "github.com/nanyte25/glitchtipctl/cmd/config"
+	"github.com/nanyte25/glitchtipctl/cmd/event"
+	"github.com/nanyte25/glitchtipctl/cmd/heartbeat"
+	"github.com/nanyte25/glitchtipctl/cmd/issue"
+	"github.com/nanyte25/glitchtipctl/cmd/key"
+	"github.com/nanyte25/glitchtipctl/cmd/login"
+	"github.com/nanyte25/glitchtipctl/cmd/member"
+	"github.com/nanyte25/glitchtipctl/cmd/monitor"
+	"github.com/nanyte25/glitchtipctl/cmd/release"
+	"github.com/nanyte25/glitchtipctl/cmd/sourcemap"
 	"github.com/nanyte25/glitchtipctl/cmd/team"
+	"github.com/nanyte25/glitchtipctl/cmd/tui"
+	"github.com/nanyte25/glitchtipctl/common"
 	"github.com/spf13/cobra"
 )
 
@@ -17,15 +29,20 @@ var rootCmd = &cobra.Command{
 	Long: `glitchtipctl is a commandline tool for interacting with the GlitchTip error tracking software.
 
 This tool provides various commands for managing organizations, projects, teams, users, and more. 
-Use this CLI to automate and manage tasks within your GlitchTip account.`,
+Use this CLI to automate and manage tasks within your GlitchTip account.
+
+` + common.ExitCodesHelp,
+	// Errors are printed once by Execute; usage is only shown on request.
+	SilenceErrors: true,
+	SilenceUsage:  true,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(common.ExitCode(err))
 	}
 }
 
@@ -35,15 +52,34 @@ func RootCmd() *cobra.Command {
 }
 
 func init() {
+	// Flag parsing errors are usage errors with their own exit code
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return &common.ValidationError{Err: err}
+	})
+
+	// Global flags shared by every command
+	rootCmd.PersistentFlags().StringVar(&common.Options.Context, "context", "", "Name of the config context to use instead of the current one")
+	rootCmd.PersistentFlags().BoolVar(&common.Options.NoSpinner, "no-spinner", false, "Do not show a progress spinner (it is only shown when stderr is a terminal)")
+	rootCmd.PersistentFlags().BoolVarP(&common.Options.Quiet, "quiet", "q", false, "Only print the requested output, without spinner or status messages")
+
 	// Add your commands here. These commands are added as subcommands of the root command.
-	rootCmd.AddCommand(project.GetProjectsCmd)
-	rootCmd.AddCommand(team.GetTeamsCmd)
-	rootCmd.AddCommand(team.CreateTeamCmd)
-	rootCmd.AddCommand(organization.CreateOrganizationCmd)
-	rootCmd.AddCommand(organization.GetOrganizationsCmd)
-
-	// Register the GetMembersCmd from the organization package
-	organization.AddGetMembersCmd(rootCmd) // Add getMembers command to root
+	// The get, create and delete trees are registered in verbs.go.
+	rootCmd.AddCommand(configcmd.ConfigCmd)
+	rootCmd.AddCommand(login.LoginCmd)
+	rootCmd.AddCommand(login.LogoutCmd)
+	rootCmd.AddCommand(login.WhoamiCmd)
+	rootCmd.AddCommand(issue.IssuesCmd)
+	rootCmd.AddCommand(event.EventsCmd)
+	rootCmd.AddCommand(key.KeysCmd)
+	rootCmd.AddCommand(release.ReleasesCmd)
+	rootCmd.AddCommand(sourcemap.SourcemapsCmd)
+	rootCmd.AddCommand(monitor.MonitorsCmd)
+	rootCmd.AddCommand(heartbeat.HeartbeatCmd)
+	rootCmd.AddCommand(alert.AlertsCmd)
+	rootCmd.AddCommand(member.MembersCmd)
+	rootCmd.AddCommand(team.TeamsCmd)
+	rootCmd.AddCommand(apply.ApplyCmd)
+	rootCmd.AddCommand(tui.TuiCmd)
 
 	// Additional commands can be added here.
 	rootCmd.Flags().BoolP("toggle", "t", false, "To toggle the debug mode")
@@ -0,0 +1,70 @@
+package sourcemap
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// bundleManifest is the manifest.json of a release bundle
+type bundleManifest struct {
+	Org     string                    `json:"org"`
+	Release string                    `json:"release"`
+	Dist    string                    `json:"dist,omitempty"`
+	Files   map[string]bundleFileInfo `json:"files"`
+}
+
+// bundleFileInfo describes one file of a release bundle
+type bundleFileInfo struct {
+	URL     string            `json:"url"`
+	Type    string            `json:"type"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// headers returns the release file headers of an artifact
+func (a *artifact) headers() map[string]string {
+	headers := map[string]string{}
+	if a.sourceMap != nil {
+		headers["Sourcemap"] = a.reference
+	}
+	if a.debugID != "" {
+		headers["debug-id"] = a.debugID
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+// buildBundle packs artifacts into a release bundle, a zip archive of the
+// files with a manifest that maps them to their URLs
+func buildBundle(orgSlug, release, dist string, artifacts []*artifact) ([]byte, error) {
+	manifest := bundleManifest{Org: orgSlug, Release: release, Dist: dist, Files: map[string]bundleFileInfo{}}
+
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for _, a := range artifacts {
+		name := "files/_/_/" + a.rel
+		writer, err := archive.Create(name)
+		if err != nil {
+			return nil, fmt.Errorf("error creating release bundle: %w", err)
+		}
+		if _, err := writer.Write(a.content); err != nil {
+			return nil, fmt.Errorf("error creating release bundle: %w", err)
+		}
+		manifest.Files[name] = bundleFileInfo{URL: a.url, Type: a.kind, Headers: a.headers()}
+	}
+
+	writer, err := archive.Create("manifest.json")
+	if err != nil {
+		return nil, fmt.Errorf("error creating release bundle: %w", err)
+	}
+	if err := json.NewEncoder(writer).Encode(manifest); err != nil {
+		return nil, fmt.Errorf("error creating release bundle: %w", err)
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("error creating release bundle: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,105 @@
+package sourcemap
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// debugIDComment is the comment that carries a script's debug ID
+const debugIDComment = "//# debugId="
+
+// debugIDPattern finds an existing debug ID comment
+var debugIDPattern = regexp.MustCompile(`(?m)^//# debugId=([0-9a-fA-F-]{36})\s*$`)
+
+// debugIDSnippet registers the debug ID of a script at runtime, so that
+// SDKs can send it with stack frames. It is the snippet sentry-cli injects.
+const debugIDSnippet = `!function(){try{var e="undefined"!=typeof window?window:"undefined"!=typeof global?global:"undefined"!=typeof self?self:{},n=(new e.Error).stack;n&&(e._sentryDebugIds=e._sentryDebugIds||{},e._sentryDebugIds[n]="%[1]s",e._sentryDebugIdIdentifier="sentry-dbid-%[1]s")}catch(e){}}();`
+
+// existingDebugID returns the debug ID already injected into a script
+func existingDebugID(content []byte) string {
+	if match := debugIDPattern.FindSubmatch(content); match != nil {
+		return strings.ToLower(string(match[1]))
+	}
+	return ""
+}
+
+// newDebugID derives a UUID from the content of a source map, so that the
+// same build always gets the same ID
+func newDebugID(content []byte) string {
+	sum := sha256.Sum256(content)
+	id := sum[:16]
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16])
+}
+
+// injectDebugID gives a script and its source map a shared debug ID. The
+// runtime snippet becomes the first line of the script, so the map's
+// mappings are shifted by one line. Scripts that already have an ID keep
+// it and are not changed. It reports whether the files were changed.
+func injectDebugID(script *artifact) (bool, error) {
+	if script.sourceMap == nil {
+		return false, nil
+	}
+	if id := existingDebugID(script.content); id != "" {
+		script.debugID, script.sourceMap.debugID = id, id
+		return false, nil
+	}
+
+	id := newDebugID(script.sourceMap.content)
+	sourceMap, shifted, err := addDebugIDToMap(script.sourceMap.content, id)
+	if err != nil {
+		return false, fmt.Errorf("error updating %s: %w", script.sourceMap.rel, err)
+	}
+
+	var content bytes.Buffer
+	if shifted {
+		fmt.Fprintf(&content, debugIDSnippet+"\n", id)
+	}
+	content.Write(bytes.TrimRight(script.content, "\n"))
+	fmt.Fprintf(&content, "\n%s%s\n", debugIDComment, id)
+
+	if err := os.WriteFile(script.path, content.Bytes(), 0o644); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(script.sourceMap.path, sourceMap, 0o644); err != nil {
+		return false, err
+	}
+	script.content, script.sourceMap.content = content.Bytes(), sourceMap
+	script.debugID, script.sourceMap.debugID = id, id
+	return true, nil
+}
+
+// addDebugIDToMap adds the debug ID to a source map and shifts its
+// mappings by one line for the snippet. Index maps with sections are not
+// shifted, which it reports so that the snippet is left out.
+func addDebugIDToMap(content []byte, id string) ([]byte, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, false, fmt.Errorf("invalid source map: %w", err)
+	}
+
+	shifted := false
+	var mappings string
+	if raw, ok := fields["mappings"]; ok && json.Unmarshal(raw, &mappings) == nil {
+		fields["mappings"], _ = json.Marshal(";" + mappings)
+		shifted = true
+	}
+	encodedID, _ := json.Marshal(id)
+	fields["debug_id"] = encodedID
+	fields["debugId"] = encodedID
+
+	// Keep characters such as < in sourcesContent as they are
+	var updated bytes.Buffer
+	encoder := json.NewEncoder(&updated)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(fields); err != nil {
+		return nil, false, err
+	}
+	return bytes.TrimRight(updated.Bytes(), "\n"), shifted, nil
+}
@@ -0,0 +1,136 @@
+package sourcemap
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newListCmd creates the sourcemaps list command
+func newListCmd() *cobra.Command {
+	var orgFlag, release string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the files of a release",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			files, err := common.FetchWithSpinner("Fetching release files...", func() ([]glitchtip.ReleaseFile, error) {
+				return client.ListReleaseFiles(context.Background(), orgSlug, release)
+			})
+			if err != nil {
+				return err
+			}
+			return releaseFilePrinter.PrintList(os.Stdout, format, files)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringVarP(&release, "release", "r", "", "Release version (required)")
+	cmd.MarkFlagRequired("release")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// newDeleteCmd creates the sourcemaps delete command
+func newDeleteCmd() *cobra.Command {
+	var orgFlag, release string
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "delete [file_id|name...]",
+		Short: "Delete files of a release",
+		Long: `Delete files of a release, given by ID or name (the URL they were uploaded as), or every file
+with --all.
+
+You are asked to type the release version to confirm, unless --yes is given.`,
+		Example: `  glitchtipctl sourcemaps delete --release 1.4.0 "~/static/js/main.js" "~/static/js/main.js.map"
+  glitchtipctl sourcemaps delete --release 1.4.0 --all --yes`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all == (len(args) > 0) {
+				return common.Validationf("give the files to delete or --all, but not both")
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var selected []glitchtip.ReleaseFile
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				files, err := client.ListReleaseFiles(ctx, orgSlug, release)
+				if err != nil {
+					return nil, err
+				}
+				if selected, err = selectFiles(files, args, all); err != nil {
+					return nil, err
+				}
+				names := make([]string, len(selected))
+				for i, file := range selected {
+					names[i] = file.Name
+				}
+				return &common.DeletionPlan{
+					Kind:    "files of release",
+					Name:    release,
+					Details: []string{common.Summarize("file", "files", names)},
+				}, nil
+			}, func(ctx context.Context) error {
+				for _, file := range selected {
+					if err := client.DeleteReleaseFile(ctx, orgSlug, release, file.ID.String()); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringVarP(&release, "release", "r", "", "Release version (required)")
+	cmd.Flags().BoolVar(&all, "all", false, "Delete every file of the release")
+	cmd.MarkFlagRequired("release")
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
+
+// selectFiles picks the files named by ID or name, or every file
+func selectFiles(files []glitchtip.ReleaseFile, names []string, all bool) ([]glitchtip.ReleaseFile, error) {
+	if all {
+		return files, nil
+	}
+	var selected []glitchtip.ReleaseFile
+	for _, name := range names {
+		found := false
+		for _, file := range files {
+			if file.ID.String() == name || file.Name == name {
+				selected = append(selected, file)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, common.Validationf("the release has no file %q", name)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,172 @@
+package sourcemap
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Artifact types of the release bundle manifest
+const (
+	typeMinifiedSource = "minified_source"
+	typeSourceMap      = "source_map"
+)
+
+// artifact is one file of a build directory to upload
+type artifact struct {
+	// path is the file on disk, rel the slash separated path below the
+	// scanned directory and url the URL the file is served from
+	path    string
+	rel     string
+	url     string
+	kind    string
+	content []byte
+	// sourceMap is the map paired with a script, and reference how the
+	// script refers to it
+	sourceMap *artifact
+	reference string
+	debugID   string
+}
+
+// scanOptions control which files scanDirectory picks up
+type scanOptions struct {
+	urlPrefix  string
+	extensions []string
+	ignore     []string
+}
+
+// scanDirectory collects the scripts and source maps below dir and pairs
+// each script with the map its sourceMappingURL comment points to
+func scanDirectory(dir string, opts scanOptions) ([]*artifact, error) {
+	var artifacts []*artifact
+	byPath := map[string]*artifact{}
+
+	err := filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel != "." && ignored(rel, opts.ignore) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() || !hasExtension(rel, opts.extensions) {
+			return nil
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		a := &artifact{path: file, rel: rel, url: joinURL(opts.urlPrefix, rel), kind: typeMinifiedSource, content: content}
+		if strings.HasSuffix(rel, ".map") {
+			a.kind = typeSourceMap
+		}
+		artifacts = append(artifacts, a)
+		byPath[rel] = a
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", dir, err)
+	}
+
+	for _, a := range artifacts {
+		if a.kind != typeMinifiedSource {
+			continue
+		}
+		reference := sourceMappingURL(a.content)
+		if reference == "" {
+			// Bundlers that omit the comment still write script.js.map
+			if guess, ok := byPath[a.rel+".map"]; ok {
+				a.sourceMap, a.reference = guess, path.Base(guess.rel)
+			}
+			continue
+		}
+		if target, ok := resolveReference(a.rel, reference); ok {
+			if sourceMap, ok := byPath[target]; ok {
+				a.sourceMap, a.reference = sourceMap, reference
+			}
+		}
+	}
+
+	// Scripts injected by an earlier run keep sending their debug ID
+	for _, a := range artifacts {
+		if a.sourceMap != nil {
+			if id := existingDebugID(a.content); id != "" {
+				a.debugID, a.sourceMap.debugID = id, id
+			}
+		}
+	}
+	return artifacts, nil
+}
+
+// sourceMappingURL returns the value of the last sourceMappingURL comment
+// of a script, or "" when there is none
+func sourceMappingURL(content []byte) string {
+	lines := bytes.Split(content, []byte("\n"))
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(string(lines[i]))
+		for _, prefix := range []string{"//# sourceMappingURL=", "//@ sourceMappingURL="} {
+			if strings.HasPrefix(line, prefix) {
+				return strings.TrimSpace(strings.TrimPrefix(line, prefix))
+			}
+		}
+	}
+	return ""
+}
+
+// resolveReference resolves a sourceMappingURL relative to the script at
+// rel. Inline data: URLs and maps on other hosts cannot be resolved.
+func resolveReference(rel, reference string) (string, bool) {
+	u, err := url.Parse(reference)
+	if err != nil || u.Scheme != "" || u.Host != "" {
+		return "", false
+	}
+	if strings.HasPrefix(u.Path, "/") {
+		return strings.TrimPrefix(path.Clean(u.Path), "/"), true
+	}
+	return path.Join(path.Dir(rel), u.Path), true
+}
+
+// joinURL places a relative path under a URL prefix such as "~/static"
+func joinURL(prefix, rel string) string {
+	if prefix == "" {
+		prefix = "~"
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + rel
+}
+
+// hasExtension reports whether name ends in one of the extensions
+func hasExtension(name string, extensions []string) bool {
+	for _, extension := range extensions {
+		if strings.HasSuffix(name, "."+strings.TrimPrefix(extension, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// ignored reports whether a path or its base name matches one of the
+// --ignore patterns
+func ignored(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, rel); matched {
+			return true
+		}
+		if matched, _ := path.Match(pattern, path.Base(rel)); matched {
+			return true
+		}
+	}
+	return false
+}
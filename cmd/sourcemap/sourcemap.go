@@ -0,0 +1,51 @@
+package sourcemap
+
+import (
+	"fmt"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// SourcemapsCmd groups the commands that manage the source maps and other
+// files of a release
+var SourcemapsCmd = &cobra.Command{
+	Use:     "sourcemaps",
+	Aliases: []string{"sourcemap"},
+	Short:   "Upload and manage the source maps of a release",
+	Long: `Upload the scripts and source maps of a JavaScript build to a release, so that GlitchTip can show
+readable stack traces for minified code, and list or delete the files of a release.`,
+}
+
+// releaseFilePrinter prints release files in every output format
+var releaseFilePrinter = common.ResourcePrinter[glitchtip.ReleaseFile]{
+	Kind: "file",
+	Name: func(file glitchtip.ReleaseFile) string { return file.Name },
+	Columns: []common.Column[glitchtip.ReleaseFile]{
+		{Header: "ID", Value: func(file glitchtip.ReleaseFile) string { return file.ID.String() }},
+		{Header: "Name", Value: func(file glitchtip.ReleaseFile) string { return file.Name }},
+		{Header: "Size", Value: func(file glitchtip.ReleaseFile) string { return formatSize(file.Size) }},
+		{Header: "Dist", Value: func(file glitchtip.ReleaseFile) string { return file.Dist }},
+		{Header: "Source Map", Value: func(file glitchtip.ReleaseFile) string { return file.Headers["Sourcemap"] }},
+		{Header: "Debug ID", Wide: true, Value: func(file glitchtip.ReleaseFile) string { return file.Headers["debug-id"] }},
+		{Header: "SHA1", Wide: true, Value: func(file glitchtip.ReleaseFile) string { return file.SHA1 }},
+		{Header: "Created", Wide: true, Value: func(file glitchtip.ReleaseFile) string { return file.DateCreated }},
+	},
+}
+
+// formatSize renders a byte count for humans
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
+
+func init() {
+	SourcemapsCmd.AddCommand(newUploadCmd(), newListCmd(), newDeleteCmd())
+}
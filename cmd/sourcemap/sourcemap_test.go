@@ -0,0 +1,182 @@
+package sourcemap
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates files below dir from a map of relative paths
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestScanDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"js/app.js":         "app();\n//# sourceMappingURL=../maps/app.js.map\n",
+		"maps/app.js.map":   `{"version":3,"mappings":"AAAA"}`,
+		"js/vendor.js":      "vendor();\n",
+		"js/vendor.js.map":  `{"version":3,"mappings":"AAAA"}`,
+		"js/inline.js":      "inline();\n//# sourceMappingURL=data:application/json;base64,e30=\n",
+		"js/app.test.js":    "test();\n",
+		"node_modules/x.js": "x();\n",
+		"css/app.css":       "body{}\n",
+	})
+
+	artifacts, err := scanDirectory(dir, scanOptions{
+		urlPrefix:  "~/static/",
+		extensions: []string{"js", "map"},
+		ignore:     []string{"*.test.js", "node_modules"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byURL := map[string]*artifact{}
+	for _, a := range artifacts {
+		byURL[a.url] = a
+	}
+	if len(byURL) != 5 {
+		t.Errorf("found %d files, want 5: %v", len(byURL), byURL)
+	}
+	app := byURL["~/static/js/app.js"]
+	if app == nil || app.sourceMap == nil || app.sourceMap.url != "~/static/maps/app.js.map" || app.reference != "../maps/app.js.map" {
+		t.Errorf("app.js = %+v, want it paired with maps/app.js.map", app)
+	}
+	vendor := byURL["~/static/js/vendor.js"]
+	if vendor == nil || vendor.sourceMap == nil || vendor.reference != "vendor.js.map" {
+		t.Errorf("vendor.js = %+v, want it paired with vendor.js.map", vendor)
+	}
+	if inline := byURL["~/static/js/inline.js"]; inline == nil || inline.sourceMap != nil {
+		t.Errorf("inline.js = %+v, want no source map", inline)
+	}
+	if m := byURL["~/static/maps/app.js.map"]; m == nil || m.kind != typeSourceMap {
+		t.Errorf("app.js.map = %+v, want a source map", m)
+	}
+}
+
+func TestResolveReference(t *testing.T) {
+	tests := []struct {
+		rel, reference, want string
+		ok                   bool
+	}{
+		{"js/app.js", "app.js.map", "js/app.js.map", true},
+		{"js/app.js", "../maps/app.js.map", "maps/app.js.map", true},
+		{"js/app.js", "/maps/app.js.map", "maps/app.js.map", true},
+		{"js/app.js", "https://cdn.example.com/app.js.map", "", false},
+		{"js/app.js", "data:application/json;base64,e30=", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := resolveReference(tt.rel, tt.reference)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("resolveReference(%q, %q) = %q, %v, want %q, %v", tt.rel, tt.reference, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestInjectDebugID(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"app.js":     "app();\n//# sourceMappingURL=app.js.map\n",
+		"app.js.map": `{"version":3,"sources":["<app>.ts"],"mappings":"AAAA"}`,
+	})
+	artifacts, err := scanDirectory(dir, scanOptions{extensions: []string{"js", "map"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var script *artifact
+	for _, a := range artifacts {
+		if a.kind == typeMinifiedSource {
+			script = a
+		}
+	}
+
+	changed, err := injectDebugID(script)
+	if err != nil || !changed {
+		t.Fatalf("injectDebugID = %v, %v", changed, err)
+	}
+	id := script.debugID
+	if id == "" || script.sourceMap.debugID != id {
+		t.Fatalf("debug IDs = %q, %q", id, script.sourceMap.debugID)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(dir, "app.js"))
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if !strings.Contains(lines[0], id) || lines[len(lines)-1] != debugIDComment+id {
+		t.Errorf("app.js = %s", content)
+	}
+
+	var sourceMap struct {
+		Mappings string   `json:"mappings"`
+		DebugID  string   `json:"debug_id"`
+		Sources  []string `json:"sources"`
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "app.js.map"))
+	if err := json.Unmarshal(data, &sourceMap); err != nil {
+		t.Fatal(err)
+	}
+	if sourceMap.Mappings != ";AAAA" || sourceMap.DebugID != id {
+		t.Errorf("app.js.map = %s", data)
+	}
+	if !bytes.Contains(data, []byte("<app>.ts")) {
+		t.Errorf("app.js.map escaped its sources: %s", data)
+	}
+
+	// A second run keeps the ID and leaves the files alone
+	changed, err = injectDebugID(script)
+	if err != nil || changed || script.debugID != id {
+		t.Errorf("second injectDebugID = %v, %v with ID %q", changed, err, script.debugID)
+	}
+}
+
+func TestBuildBundle(t *testing.T) {
+	sourceMap := &artifact{rel: "app.js.map", url: "~/app.js.map", kind: typeSourceMap, content: []byte("{}"), debugID: "id"}
+	script := &artifact{rel: "app.js", url: "~/app.js", kind: typeMinifiedSource, content: []byte("app();"), sourceMap: sourceMap, reference: "app.js.map", debugID: "id"}
+
+	data, err := buildBundle("acme", "1.0", "42", []*artifact{script, sourceMap})
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{}
+	for _, file := range archive.File {
+		reader, _ := file.Open()
+		content, _ := io.ReadAll(reader)
+		reader.Close()
+		files[file.Name] = string(content)
+	}
+	if files["files/_/_/app.js"] != "app();" {
+		t.Errorf("bundle files = %v", files)
+	}
+
+	var manifest bundleManifest
+	if err := json.Unmarshal([]byte(files["manifest.json"]), &manifest); err != nil {
+		t.Fatal(err)
+	}
+	info := manifest.Files["files/_/_/app.js"]
+	if manifest.Org != "acme" || manifest.Release != "1.0" || manifest.Dist != "42" {
+		t.Errorf("manifest = %+v", manifest)
+	}
+	if info.URL != "~/app.js" || info.Type != typeMinifiedSource || info.Headers["Sourcemap"] != "app.js.map" || info.Headers["debug-id"] != "id" {
+		t.Errorf("app.js entry = %+v", info)
+	}
+}
@@ -0,0 +1,223 @@
+package sourcemap
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// defaultFileConcurrency is the number of parallel uploads to servers
+// without chunked uploads
+const defaultFileConcurrency = 4
+
+// uploadFlags holds the flags of the upload command
+type uploadFlags struct {
+	org            string
+	release        string
+	urlPrefix      string
+	dist           string
+	extensions     []string
+	ignore         []string
+	injectDebugIDs bool
+	dryRun         bool
+	concurrency    int
+}
+
+// newUploadCmd creates the sourcemaps upload command
+func newUploadCmd() *cobra.Command {
+	var flags uploadFlags
+
+	cmd := &cobra.Command{
+		Use:   "upload <dir>...",
+		Short: "Upload the scripts and source maps of a build directory to a release",
+		Long: `Upload the scripts and source maps of a build directory to a release.
+
+Every .js, .mjs and .cjs file is paired with the source map its sourceMappingURL comment points to,
+or with <file>.map when there is no comment. Files are uploaded under --url-prefix followed by
+their path below the directory, which must match the URL they are served from ("~" matches any
+host).
+
+With --inject-debug-ids a debug ID is written into each paired script and its source map before
+the upload, as sentry-cli's "sourcemaps inject" does. The files are changed in place, so deploy
+them after running the command.
+
+Servers with chunked uploads receive one bundle in concurrent chunks, other servers one request
+per file. Failed requests are retried.`,
+		Example: `  glitchtipctl sourcemaps upload build/ --release 1.4.0 --url-prefix "~/static"
+  glitchtipctl sourcemaps upload dist/ --release "$VERSION" --inject-debug-ids --ignore "*.test.js"`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUpload(args, flags)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &flags.org)
+	cmd.Flags().StringVarP(&flags.release, "release", "r", "", "Release version to upload to (required)")
+	cmd.Flags().StringVar(&flags.urlPrefix, "url-prefix", "~/", "URL prefix of the uploaded files")
+	cmd.Flags().StringVar(&flags.dist, "dist", "", "Distribution of the release, e.g. a build number")
+	cmd.Flags().StringSliceVar(&flags.extensions, "ext", []string{"js", "mjs", "cjs", "map"}, "File extensions to upload")
+	cmd.Flags().StringArrayVar(&flags.ignore, "ignore", nil, "Glob pattern of files or directories to skip (repeatable)")
+	cmd.Flags().BoolVar(&flags.injectDebugIDs, "inject-debug-ids", false, "Write debug IDs into the scripts and source maps before uploading")
+	cmd.Flags().BoolVar(&flags.dryRun, "dry-run", false, "Only print the files that would be uploaded")
+	cmd.Flags().IntVar(&flags.concurrency, "concurrency", 0, "Number of parallel upload requests (0 uses the server's recommendation)")
+	cmd.MarkFlagRequired("release")
+	return cmd
+}
+
+// runUpload scans the directories and uploads what it found
+func runUpload(dirs []string, flags uploadFlags) error {
+	if flags.concurrency < 0 {
+		return common.Validationf("--concurrency must not be negative")
+	}
+	orgSlug, err := common.ResolveOrganization(flags.org)
+	if err != nil {
+		return err
+	}
+
+	var artifacts []*artifact
+	urls := map[string]string{}
+	for _, dir := range dirs {
+		found, err := scanDirectory(dir, scanOptions{urlPrefix: flags.urlPrefix, extensions: flags.extensions, ignore: flags.ignore})
+		if err != nil {
+			return err
+		}
+		for _, a := range found {
+			if other, ok := urls[a.url]; ok {
+				return common.Validationf("%s and %s would both be uploaded as %s", other, a.path, a.url)
+			}
+			urls[a.url] = a.path
+		}
+		artifacts = append(artifacts, found...)
+	}
+	if len(artifacts) == 0 {
+		return common.Validationf("no files with the extensions %v found", flags.extensions)
+	}
+
+	if flags.dryRun {
+		printArtifacts(artifacts)
+		common.Infof("Would upload %d files to release %s", len(artifacts), flags.release)
+		return nil
+	}
+
+	client, err := common.NewClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if _, err := client.GetRelease(ctx, orgSlug, flags.release); err != nil {
+		if glitchtip.IsNotFound(err) {
+			return fmt.Errorf("release %s does not exist, create it with \"glitchtipctl releases new\" first: %w", flags.release, err)
+		}
+		return err
+	}
+
+	// Only change the files once the upload can go ahead
+	if flags.injectDebugIDs {
+		injected := 0
+		for _, a := range artifacts {
+			changed, err := injectDebugID(a)
+			if err != nil {
+				return err
+			}
+			if changed {
+				injected++
+			}
+		}
+		common.Infof("Injected debug IDs into %d scripts", injected)
+	}
+
+	_, err = common.FetchWithSpinner(fmt.Sprintf("Uploading %d files...", len(artifacts)), func() (struct{}, error) {
+		return struct{}{}, uploadArtifacts(ctx, client, orgSlug, flags, artifacts)
+	})
+	if err != nil {
+		return err
+	}
+	printArtifacts(artifacts)
+	common.Infof("Uploaded %d files to release %s", len(artifacts), flags.release)
+	return nil
+}
+
+// uploadArtifacts sends the files as one chunked bundle, or one by one to
+// servers without chunked uploads
+func uploadArtifacts(ctx context.Context, client *glitchtip.Client, orgSlug string, flags uploadFlags, artifacts []*artifact) error {
+	options, err := client.GetChunkUploadOptions(ctx, orgSlug)
+	if err != nil && !glitchtip.IsNotFound(err) {
+		return err
+	}
+	if err != nil || !options.Accepts("release_files") {
+		return uploadFiles(ctx, client, orgSlug, flags, artifacts)
+	}
+
+	bundle, err := buildBundle(orgSlug, flags.release, flags.dist, artifacts)
+	if err != nil {
+		return err
+	}
+	opts := glitchtip.BundleUploadOptions{Concurrency: flags.concurrency}
+	if !common.SpinnerEnabled() {
+		opts.Progress = func(done, total int) {
+			common.Infof("Uploaded %d of %d chunks", done, total)
+		}
+	}
+	return client.UploadReleaseBundle(ctx, orgSlug, flags.release, options, bundle, opts)
+}
+
+// uploadFiles uploads every artifact in its own request, several at a
+// time
+func uploadFiles(ctx context.Context, client *glitchtip.Client, orgSlug string, flags uploadFlags, artifacts []*artifact) error {
+	concurrency := flags.concurrency
+	if concurrency == 0 {
+		concurrency = defaultFileConcurrency
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	work := make(chan *artifact)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for a := range work {
+				_, err := client.UploadReleaseFile(ctx, orgSlug, flags.release, glitchtip.ReleaseFileUpload{
+					Name:    a.url,
+					Dist:    flags.dist,
+					Headers: a.headers(),
+					Content: a.content,
+				})
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = fmt.Errorf("error uploading %s: %w", a.path, err)
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, a := range artifacts {
+		work <- a
+	}
+	close(work)
+	wg.Wait()
+	return firstErr
+}
+
+// printArtifacts lists the files of an upload
+func printArtifacts(artifacts []*artifact) {
+	table := common.NewTable(os.Stdout, []string{"URL", "Type", "Size", "Source Map", "Debug ID"})
+	for _, a := range artifacts {
+		sourceMap := ""
+		if a.sourceMap != nil {
+			sourceMap = a.sourceMap.url
+		}
+		table.Append([]string{a.url, a.kind, formatSize(int64(len(a.content))), sourceMap, a.debugID})
+	}
+	table.Render()
+}
@@ -0,0 +1,60 @@
+package team
+
+import (
+	"context"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the command that deletes a team
+func NewDeleteCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "team <team_slug>",
+		Short: "Delete a team from an organization",
+		Long: `Delete a team from an organization. The team's projects are kept, but lose the team's members'
+access through it.
+
+You are asked to type the team slug again to confirm, unless --yes is given. Use --dry-run to list
+the projects of the team.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+			teamSlug := args[0]
+
+			return common.RunDeletion(cmd, func(ctx context.Context) (*common.DeletionPlan, error) {
+				team, err := client.GetTeam(ctx, orgSlug, teamSlug)
+				if err != nil {
+					return nil, err
+				}
+				projectSlugs := make([]string, len(team.Projects))
+				for i, project := range team.Projects {
+					projectSlugs[i] = project.Slug
+				}
+				return &common.DeletionPlan{
+					Kind:  "team",
+					Name:  team.Slug,
+					Title: orgSlug + "/" + team.Slug,
+					Details: []string{
+						common.Summarize("project", "projects", projectSlugs) + " (the projects are kept)",
+					},
+				}, nil
+			}, func(ctx context.Context) error {
+				return client.DeleteTeam(ctx, orgSlug, teamSlug)
+			})
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddDeleteFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,83 @@
+package team
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// teamDescription is everything describe shows about a team
+type teamDescription struct {
+	Team    *glitchtip.Team
+	Members []glitchtip.Member
+}
+
+// NewDescribeCmd creates the command that shows a team in detail
+func NewDescribeCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:     "team <organization_slug>/<team_slug>",
+		Aliases: []string{"teams"},
+		Short:   "Show a team with its members and projects",
+		Long: `Show a team with its members and projects.
+
+The team can be given as <organization>/<team>, or as a bare slug in the organization of --org or
+the current context.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, teamSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			description, err := common.FetchWithSpinner("Fetching team...", func() (*teamDescription, error) {
+				ctx := context.Background()
+				team, err := client.GetTeam(ctx, orgSlug, teamSlug)
+				if err != nil {
+					return nil, err
+				}
+				members, err := client.ListTeamMembers(ctx, orgSlug, teamSlug)
+				if err != nil {
+					return nil, err
+				}
+				return &teamDescription{Team: team, Members: members}, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			team := description.Team
+			d := common.NewDescriptionWriter(os.Stdout)
+			d.Field(0, "Slug", team.Slug)
+			d.Field(0, "ID", team.ID)
+			d.Field(0, "Organization", orgSlug)
+			d.Field(0, "Created", team.DateCreated)
+
+			d.Section(0, "Members", len(description.Members) == 0)
+			for _, member := range description.Members {
+				role := member.Role
+				if member.Pending {
+					role += " (invited)"
+				}
+				d.Row(1, member.Email, member.Name, role)
+			}
+
+			d.Section(0, "Projects", len(team.Projects) == 0)
+			for _, project := range team.Projects {
+				d.Row(1, project.Slug, project.Name, project.Platform)
+			}
+			return d.Flush()
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
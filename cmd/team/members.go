@@ -0,0 +1,142 @@
+package team
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/cmd/member"
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newMembersCmd creates the command that lists the members of a team
+func newMembersCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "members <team>",
+		Short: "List the members of a team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, teamSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			members, err := common.FetchWithSpinner("Fetching team members...", func() ([]glitchtip.Member, error) {
+				return client.ListTeamMembers(context.Background(), orgSlug, teamSlug)
+			})
+			if err != nil {
+				return err
+			}
+			return member.Printer.PrintList(os.Stdout, format, members)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// newAddMemberCmd creates the command that adds a member to a team
+func newAddMemberCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "add-member <team> <email|member_id>",
+		Short: "Add an organization member to a team",
+		Long: `Add an organization member to a team. The member gets access to the projects of the team.
+Pending invitations can be added as well; they join the team when they accept.`,
+		Example: `  glitchtipctl teams add-member backend ann@example.com
+  glitchtipctl teams add-member my-org/backend 7`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, teamSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var added bool
+			found, err := common.FetchWithSpinner("Adding member...", func() (*glitchtip.Member, error) {
+				ctx := context.Background()
+				found, err := client.FindMember(ctx, orgSlug, args[1])
+				if err != nil || contains(found.Teams, teamSlug) {
+					return found, err
+				}
+				added = true
+				return found, client.AddTeamMember(ctx, orgSlug, teamSlug, found.ID.String())
+			})
+			if err != nil {
+				return err
+			}
+			if added {
+				common.Infof("Added %s to team %s", found.Email, teamSlug)
+			} else {
+				common.Infof("%s is already a member of team %s", found.Email, teamSlug)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
+
+// newRemoveMemberCmd creates the command that removes a member from a team
+func newRemoveMemberCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "remove-member <team> <email|member_id>",
+		Short: "Remove a member from a team",
+		Long: `Remove a member from a team. The member stays in the organization, but loses access to the
+projects of the team. Use "members remove" to remove a user from the organization.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, teamSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var removed bool
+			found, err := common.FetchWithSpinner("Removing member...", func() (*glitchtip.Member, error) {
+				ctx := context.Background()
+				found, err := client.FindMember(ctx, orgSlug, args[1])
+				if err != nil || !contains(found.Teams, teamSlug) {
+					return found, err
+				}
+				removed = true
+				return found, client.RemoveTeamMember(ctx, orgSlug, teamSlug, found.ID.String())
+			})
+			if err != nil {
+				return err
+			}
+			if removed {
+				common.Infof("Removed %s from team %s", found.Email, teamSlug)
+			} else {
+				common.Infof("%s is not a member of team %s", found.Email, teamSlug)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
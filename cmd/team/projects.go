@@ -0,0 +1,137 @@
+package team
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// newAddProjectCmd creates the command that assigns a project to a team
+func newAddProjectCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "add-project <team> <project>",
+		Short: "Give a team access to a project",
+		Long: `Give a team access to a project. A project can belong to several teams; the teams it already
+belongs to are kept.`,
+		Example: `  glitchtipctl teams add-project backend web
+  glitchtipctl teams add-project my-org/backend my-org/web`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, teamSlug, projectSlug, err := resolveTeamProject(args, orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			var added bool
+			_, err = common.FetchWithSpinner("Adding project...", func() (*glitchtip.Project, error) {
+				ctx := context.Background()
+				project, err := client.GetProject(ctx, orgSlug, projectSlug)
+				if err != nil || contains(projectTeams(project), teamSlug) {
+					return project, err
+				}
+				added = true
+				return client.AddTeamProject(ctx, orgSlug, teamSlug, projectSlug)
+			})
+			if err != nil {
+				return err
+			}
+			if added {
+				common.Infof("Added project %s to team %s", projectSlug, teamSlug)
+			} else {
+				common.Infof("Project %s already belongs to team %s", projectSlug, teamSlug)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
+
+// newRemoveProjectCmd creates the command that takes a project away from a
+// team
+func newRemoveProjectCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:   "remove-project <team> <project>",
+		Short: "Take the access to a project away from a team",
+		Long: `Take the access to a project away from a team. The project and its events are kept. A project
+that belongs to no team is only visible to organization owners and managers.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgSlug, teamSlug, projectSlug, err := resolveTeamProject(args, orgFlag)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			project, err := common.FetchWithSpinner("Removing project...", func() (*glitchtip.Project, error) {
+				ctx := context.Background()
+				project, err := client.GetProject(ctx, orgSlug, projectSlug)
+				if err != nil || !contains(projectTeams(project), teamSlug) {
+					return project, err
+				}
+				return project, client.RemoveTeamProject(ctx, orgSlug, teamSlug, projectSlug)
+			})
+			if err != nil {
+				return err
+			}
+			teams := projectTeams(project)
+			if !contains(teams, teamSlug) {
+				common.Infof("Project %s does not belong to team %s", projectSlug, teamSlug)
+				return nil
+			}
+			common.Infof("Removed project %s from team %s", projectSlug, teamSlug)
+			if len(teams) == 1 {
+				common.Infof("Project %s no longer belongs to any team", projectSlug)
+			}
+			return nil
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	return cmd
+}
+
+// resolveTeamProject resolves the team and project arguments, which must
+// belong to the same organization
+func resolveTeamProject(args []string, orgFlag string) (string, string, string, error) {
+	orgSlug, teamSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+	if err != nil {
+		return "", "", "", err
+	}
+	// A bare project slug belongs to the organization of the team
+	projectOrg, projectSlug, scoped := strings.Cut(args[1], "/")
+	if !scoped {
+		return orgSlug, teamSlug, args[1], nil
+	}
+	if projectOrg != orgSlug {
+		return "", "", "", common.Validationf("project %q is not in organization %q of team %q", args[1], orgSlug, teamSlug)
+	}
+	if projectSlug == "" || strings.Contains(projectSlug, "/") {
+		return "", "", "", common.Validationf("%q is not of the form <organization>/<slug>", args[1])
+	}
+	return orgSlug, teamSlug, projectSlug, nil
+}
+
+// projectTeams returns the slugs of the teams a project belongs to
+func projectTeams(project *glitchtip.Project) []string {
+	slugs := make([]string, len(project.Teams))
+	for i, team := range project.Teams {
+		slugs[i] = team.Slug
+	}
+	return slugs
+}
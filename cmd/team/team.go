@@ -1,141 +1,102 @@
 package team
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
-	"time"
 
-	"github.com/charmbracelet/bubbles/spinner"
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/olekukonko/tablewriter"
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
 	"github.com/spf13/cobra"
 )
 
-// GetTeamsCmd represents the getTeams command
-var GetTeamsCmd = &cobra.Command{
-	Use:   "getTeams",
-	Short: "Get a list of teams from your organization",
-	Long: `Get a list of teams from your organization. This command makes an HTTP GET request to the GlitchTip API
-and prints out the list of teams.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		apiToken := os.Getenv("GLITCHTIP_API_TOKEN")
-		if apiToken == "" {
-			fmt.Println("Error: GLITCHTIP_API_TOKEN environment variable is not set.")
-			return
-		}
-
-		// Start the spinner model
-		model := newSpinnerModel(apiToken)
-		program := tea.NewProgram(model)
+// TeamsCmd groups the commands that manage what a team contains
+var TeamsCmd = &cobra.Command{
+	Use:     "teams",
+	Aliases: []string{"team"},
+	Short:   "Manage the members and projects of teams",
+	Long: `List teams, and add members and projects to them or remove them again.
 
-		// Run the spinner and handle errors
-		if err := program.Start(); err != nil {
-			fmt.Printf("Error: %v\n", err)
-		}
-	},
+Teams can be given as <organization>/<team>, or as a bare slug in the organization of --org or the
+current context. Members are given by email address or member ID.`,
 }
 
-// Spinner model to display the spinner while loading
-type spinnerModel struct {
-	spinner  spinner.Model
-	quitting bool
-	apiToken string
-	result   string
-	err      error
+// NewGetCmd creates the "get teams" command, the team list in the
+// verb/noun command tree
+func NewGetCmd() *cobra.Command {
+	cmd := newListCmd()
+	cmd.Use = "teams"
+	cmd.Aliases = []string{"team"}
+	return cmd
 }
 
-func newSpinnerModel(apiToken string) spinnerModel {
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	return spinnerModel{spinner: s, apiToken: apiToken}
-}
+// newListCmd creates the command that lists teams
+func newListCmd() *cobra.Command {
+	var orgSlug string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get a list of teams from your organizations",
+		Long: `Get a list of teams from your organizations. This command makes an HTTP GET request to the GlitchTip API
+and prints out the list of teams. Use --org to only list the teams of one organization.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			teams, err := common.FetchWithSpinner("Fetching teams...", func() ([]glitchtip.Team, error) {
+				if orgSlug != "" {
+					return client.ListOrganizationTeams(context.Background(), orgSlug, opts)
+				}
+				return client.ListTeams(context.Background(), opts)
+			})
+			if err != nil {
+				return err
+			}
+			return teamPrinter.PrintList(os.Stdout, format, teams)
+		},
+	}
 
-func (m spinnerModel) Init() tea.Cmd {
-	// Start the spinner and fetch teams concurrently
-	return tea.Batch(m.spinner.Tick, m.fetchTeams)
+	cmd.Flags().StringVar(&orgSlug, "org", "", "Only list the teams of this organization")
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
 }
 
-func (m spinnerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case spinner.TickMsg:
-		var cmd tea.Cmd
-		m.spinner, cmd = m.spinner.Update(msg)
-		return m, cmd
-	case string:
-		m.quitting = true
-		m.result = msg
-		return m, tea.Quit
-	case error:
-		m.quitting = true
-		m.err = msg
-		return m, tea.Quit
-	}
-	return m, nil
+// teamPrinter prints teams in every output format
+var teamPrinter = common.ResourcePrinter[glitchtip.Team]{
+	Kind: "team",
+	Name: func(team glitchtip.Team) string { return team.Slug },
+	Columns: []common.Column[glitchtip.Team]{
+		{Header: "ID", Value: func(team glitchtip.Team) string { return team.ID.String() }},
+		{Header: "Slug", Value: func(team glitchtip.Team) string { return team.Slug }},
+		{Header: "Members", Value: func(team glitchtip.Team) string { return fmt.Sprintf("%d", team.MemberCount) }},
+		{Header: "Projects", Wide: true, Value: func(team glitchtip.Team) string { return fmt.Sprintf("%d", len(team.Projects)) }},
+		{Header: "Created", Wide: true, Value: func(team glitchtip.Team) string { return team.DateCreated }},
+	},
 }
 
-func (m spinnerModel) View() string {
-	if m.quitting {
-		if m.err != nil {
-			return fmt.Sprintf("Error: %v\n", m.err)
+// contains reports whether slugs holds slug
+func contains(slugs []string, slug string) bool {
+	for _, s := range slugs {
+		if s == slug {
+			return true
 		}
-		return fmt.Sprintf("%s\n", m.result)
-	}
-	return fmt.Sprintf("\n\n   %s Fetching teams...\n\n", m.spinner.View())
-}
-
-func (m spinnerModel) fetchTeams() tea.Msg {
-	// Introduce a delay to simulate network latency (for testing)
-	time.Sleep(2 * time.Second) // Artificial delay for spinner visibility
-
-	// Make the API call to get teams
-	url := "http://localhost:8000/api/0/teams/"
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return err
 	}
-
-	req.Header.Add("Authorization", "Bearer "+m.apiToken)
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body) // Replaces ioutil.ReadAll
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP request failed with status %d", resp.StatusCode)
-	}
-
-	var teams []map[string]interface{}
-	if err := json.Unmarshal(body, &teams); err != nil {
-		return err
-	}
-
-	// Create a table of teams
-	var tableString bytes.Buffer
-	table := tablewriter.NewWriter(&tableString)
-	table.SetHeader([]string{"ID", "Name", "Slug"})
-
-	for _, team := range teams {
-		id := fmt.Sprintf("%v", team["id"])
-		name := fmt.Sprintf("%v", team["name"])
-		slug := fmt.Sprintf("%v", team["slug"])
-		table.Append([]string{id, name, slug})
-	}
-	table.Render()
-
-	return tableString.String()
+	return false
 }
 
 func init() {
-	// Add the getTeams command to the root
+	TeamsCmd.AddCommand(newListCmd(), newMembersCmd(), newAddMemberCmd(), newRemoveMemberCmd(), newAddProjectCmd(), newRemoveProjectCmd())
 }
@@ -0,0 +1,42 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/common"
+)
+
+func TestResolveTeamProject(t *testing.T) {
+	tests := []struct {
+		args               []string
+		org, team, project string
+	}{
+		{[]string{"acme/ops", "web"}, "acme", "ops", "web"},
+		{[]string{"acme/ops", "acme/web"}, "acme", "ops", "web"},
+		{[]string{"ops", "web"}, "acme", "ops", "web"},
+	}
+	for _, tt := range tests {
+		org, team, project, err := resolveTeamProject(tt.args, "acme")
+		if err != nil || org != tt.org || team != tt.team || project != tt.project {
+			t.Errorf("resolveTeamProject(%q) = %q, %q, %q, %v", tt.args, org, team, project, err)
+		}
+	}
+
+	for _, args := range [][]string{{"acme/ops", "other/web"}, {"acme/ops", "acme/"}, {"acme/ops", "acme/a/b"}, {"other/ops", "web"}} {
+		if _, _, _, err := resolveTeamProject(args, "acme"); common.ExitCode(err) != common.ExitValidation {
+			t.Errorf("resolveTeamProject(%q) = %v, want a validation error", args, err)
+		}
+	}
+}
+
+func TestCreateOrgFlag(t *testing.T) {
+	for _, args := range [][]string{{"-o", "acme"}, {"--org", "acme"}, {"--organization", "acme"}} {
+		cmd := NewCreateCmd()
+		if err := cmd.ParseFlags(append(args, "-n", "ops")); err != nil {
+			t.Fatalf("parsing %q: %v", args, err)
+		}
+		if org, _ := cmd.Flags().GetString("org"); org != "acme" {
+			t.Errorf("%q: --org = %q, want acme", args, org)
+		}
+	}
+}
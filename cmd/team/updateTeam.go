@@ -0,0 +1,77 @@
+package team
+
+import (
+	"context"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewUpdateCmd creates the command that renames a team
+func NewUpdateCmd() *cobra.Command {
+	var orgFlag, slug string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:     "team <team> --slug <new_slug>",
+		Aliases: []string{"teams"},
+		Short:   "Rename a team",
+		Long: `Rename a team by changing its slug. Its members and projects are kept. Use --dry-run to see the
+change without making it.`,
+		Example: `  glitchtipctl update team my-org/backend --slug platform`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			orgSlug, teamSlug, err := common.ResolveScopedSlug(args[0], orgFlag)
+			if err != nil {
+				return err
+			}
+			if slug == "" || slug != glitchtip.Slugify(slug) {
+				return common.Validationf("%q is not a valid slug, use lowercase letters, digits and dashes", slug)
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			before, err := common.FetchWithSpinner("Fetching team...", func() (*glitchtip.Team, error) {
+				return client.GetTeam(ctx, orgSlug, teamSlug)
+			})
+			if err != nil {
+				return err
+			}
+			after := *before
+			after.Slug = slug
+			if dryRun || slug == before.Slug {
+				return teamPrinter.PrintUpdate(os.Stdout, format, teamFields, *before, after)
+			}
+
+			updated, err := common.FetchWithSpinner("Updating team...", func() (*glitchtip.Team, error) {
+				return client.UpdateTeam(ctx, orgSlug, teamSlug, glitchtip.TeamUpdateRequest{Slug: slug})
+			})
+			if err != nil {
+				return err
+			}
+			common.Infof("Team %s renamed to %s", teamSlug, updated.Slug)
+			return teamPrinter.PrintUpdate(os.Stdout, format, teamFields, *before, *updated)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	cmd.Flags().StringVar(&slug, "slug", "", "New slug of the team")
+	cmd.MarkFlagRequired("slug")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the change without making it")
+	common.AddOutputFlag(cmd)
+	return cmd
+}
+
+// teamFields are the settings compared by update
+var teamFields = []common.Column[glitchtip.Team]{
+	{Header: "slug", Value: func(team glitchtip.Team) string { return team.Slug }},
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// organizationItem is an organization in the org picker
+type organizationItem struct {
+	org glitchtip.Organization
+}
+
+func (i organizationItem) Title() string       { return i.org.Name }
+func (i organizationItem) Description() string { return i.org.Slug }
+func (i organizationItem) FilterValue() string { return i.org.Name + " " + i.org.Slug }
+
+// projectItem is a project in the project picker. A nil project stands
+// for every project of the organization.
+type projectItem struct {
+	project *glitchtip.Project
+}
+
+func (i projectItem) Title() string {
+	if i.project == nil {
+		return "All projects"
+	}
+	return i.project.Name
+}
+
+func (i projectItem) Description() string {
+	if i.project == nil {
+		return "Issues of every project in the organization"
+	}
+	return strings.TrimSpace(i.project.Slug + " " + i.project.Platform)
+}
+
+func (i projectItem) FilterValue() string { return i.Title() + " " + i.Description() }
+
+// issueItem is an issue in the issue list
+type issueItem struct {
+	issue glitchtip.Issue
+}
+
+func (i issueItem) Title() string {
+	return fmt.Sprintf("[%s] %s", i.issue.Level, i.issue.Title)
+}
+
+func (i issueItem) Description() string {
+	parts := []string{i.issue.ShortID}
+	if i.issue.Project != nil {
+		parts = append(parts, i.issue.Project.Slug)
+	}
+	parts = append(parts, i.issue.Count.String()+" events", "last seen "+i.issue.LastSeen)
+	if i.issue.Status != glitchtip.IssueStatusUnresolved {
+		parts = append(parts, i.issue.Status)
+	}
+	return strings.Join(parts, " · ")
+}
+
+func (i issueItem) FilterValue() string {
+	return i.issue.Title + " " + i.issue.ShortID + " " + i.issue.Culprit
+}
+
+// assigneeItem is a member in the assignee picker. A nil member stands
+// for leaving the issue unassigned.
+type assigneeItem struct {
+	member *glitchtip.Member
+}
+
+func (i assigneeItem) Title() string {
+	if i.member == nil {
+		return "Unassigned"
+	}
+	if i.member.Name != "" {
+		return i.member.Name
+	}
+	return i.member.Email
+}
+
+func (i assigneeItem) Description() string {
+	if i.member == nil {
+		return "Remove the assignee of the issue"
+	}
+	return i.member.Email + " · " + i.member.Role
+}
+
+func (i assigneeItem) FilterValue() string { return i.Title() + " " + i.Description() }
@@ -0,0 +1,652 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nanyte25/glitchtipctl/cmd/event"
+	issuecmd "github.com/nanyte25/glitchtipctl/cmd/issue"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// screen is the part of the UI that has the focus
+type screen int
+
+const (
+	screenOrganizations screen = iota
+	screenProjects
+	screenIssues
+	screenAssignees
+)
+
+// Messages delivered by the commands that talk to the API
+type (
+	organizationsMsg struct {
+		orgs []glitchtip.Organization
+		err  error
+	}
+	projectsMsg struct {
+		projects []glitchtip.Project
+		err      error
+	}
+	issuesMsg struct {
+		issues []glitchtip.Issue
+		err    error
+	}
+	eventMsg struct {
+		issueID string
+		event   *glitchtip.Event
+		err     error
+	}
+	membersMsg struct {
+		org     string
+		members []glitchtip.Member
+		err     error
+	}
+	issueUpdatedMsg struct {
+		issue  glitchtip.Issue
+		change string // e.g. "resolved", for the status message
+		err    error
+	}
+	// refreshMsg is a tick of the refresh loop started when the issue
+	// screen was opened for the gen'th time
+	refreshMsg struct {
+		gen int
+	}
+)
+
+// Keys of the issue screen, also shown in the list help
+var (
+	resolveKey   = key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "resolve"))
+	ignoreKey    = key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "ignore"))
+	unresolveKey = key.NewBinding(key.WithKeys("u"), key.WithHelp("u", "unresolve"))
+	assignKey    = key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "assign"))
+	refreshKey   = key.NewBinding(key.WithKeys("ctrl+r"), key.WithHelp("ctrl+r", "refresh"))
+	focusKey     = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "detail"))
+	backKey      = key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back"))
+	quitKey      = key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit"))
+)
+
+var (
+	headerStyle  = lipgloss.NewStyle().Bold(true).Padding(0, 1)
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Padding(0, 1)
+	paneStyle    = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("8"))
+	focusedStyle = paneStyle.Copy().BorderForeground(lipgloss.Color("4"))
+)
+
+// model is the state of the issue browser
+type model struct {
+	client  *glitchtip.Client
+	ctx     context.Context
+	query   string
+	refresh time.Duration
+
+	screen      screen
+	org         string
+	project     *glitchtip.Project
+	projectSlug string // requested with --project, resolved once projects load
+	pickedOrg   bool   // whether the org picker was shown and esc can return to it
+
+	orgs        list.Model
+	projects    list.Model
+	issues      list.Model
+	assignees   list.Model
+	detail      viewport.Model
+	focusDetail bool
+	spinner     spinner.Model
+	loading     int
+
+	events     map[string]*glitchtip.Event
+	members    map[string][]glitchtip.Member // by organization
+	assigning  glitchtip.Issue               // the issue the assignee picker is for
+	refreshGen int                           // only ticks of this refresh loop are handled
+	updatedAt  time.Time
+	err        error
+	fatal      error
+	width      int
+	height     int
+}
+
+func newModel(client *glitchtip.Client, org, project, query string, refresh time.Duration) model {
+	m := model{
+		client:      client,
+		ctx:         context.Background(),
+		query:       query,
+		refresh:     refresh,
+		org:         org,
+		projectSlug: project,
+		orgs:        newList("Organizations"),
+		projects:    newList("Projects"),
+		issues:      newList("Issues"),
+		assignees:   newList("Assign to"),
+		detail:      viewport.New(0, 0),
+		spinner:     spinner.New(spinner.WithSpinner(spinner.Dot)),
+		events:      map[string]*glitchtip.Event{},
+		members:     map[string][]glitchtip.Member{},
+	}
+	m.orgs.AdditionalShortHelpKeys = func() []key.Binding { return []key.Binding{quitKey} }
+	m.projects.AdditionalShortHelpKeys = func() []key.Binding { return []key.Binding{backKey, quitKey} }
+	m.issues.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{resolveKey, ignoreKey, unresolveKey, assignKey, refreshKey, focusKey, backKey}
+	}
+	m.assignees.AdditionalShortHelpKeys = func() []key.Binding { return []key.Binding{backKey, quitKey} }
+
+	if org == "" {
+		m.screen = screenOrganizations
+		m.pickedOrg = true
+	} else {
+		m.screen = screenProjects
+	}
+	return m
+}
+
+func newList(title string) list.Model {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = title
+	l.DisableQuitKeybindings()
+	return l
+}
+
+func (m model) Init() tea.Cmd {
+	if m.screen == screenOrganizations {
+		return m.startLoading(m.loadOrganizations())
+	}
+	return m.startLoading(m.loadProjects())
+}
+
+// startLoading counts a running request and starts the spinner for the
+// first one
+func (m *model) startLoading(cmd tea.Cmd) tea.Cmd {
+	m.loading++
+	if m.loading == 1 {
+		return tea.Batch(cmd, m.spinner.Tick)
+	}
+	return cmd
+}
+
+func (m *model) doneLoading() {
+	if m.loading > 0 {
+		m.loading--
+	}
+}
+
+func (m model) loadOrganizations() tea.Cmd {
+	return func() tea.Msg {
+		orgs, err := m.client.ListOrganizations(m.ctx, nil)
+		return organizationsMsg{orgs, err}
+	}
+}
+
+func (m model) loadProjects() tea.Cmd {
+	org := m.org
+	return func() tea.Msg {
+		projects, err := m.client.ListOrganizationProjects(m.ctx, org, nil)
+		return projectsMsg{projects, err}
+	}
+}
+
+func (m model) loadIssues() tea.Cmd {
+	org := m.org
+	filter := &glitchtip.IssueFilter{Query: m.query, Sort: glitchtip.IssueSorts["date"]}
+	if m.project != nil {
+		filter.ProjectIDs = []glitchtip.ID{m.project.ID}
+	}
+	return func() tea.Msg {
+		issues, err := m.client.ListIssues(m.ctx, org, filter, &glitchtip.ListOptions{Limit: 100})
+		return issuesMsg{issues, err}
+	}
+}
+
+func (m model) loadEvent(issueID string) tea.Cmd {
+	return func() tea.Msg {
+		event, err := m.client.GetLatestIssueEvent(m.ctx, issueID)
+		return eventMsg{issueID, event, err}
+	}
+}
+
+func (m model) loadMembers() tea.Cmd {
+	org := m.org
+	return func() tea.Msg {
+		members, err := m.client.ListMembers(m.ctx, org, nil)
+		return membersMsg{org, members, err}
+	}
+}
+
+func (m model) updateIssue(issue glitchtip.Issue, update glitchtip.IssueUpdate, change string) tea.Cmd {
+	org := m.org
+	return func() tea.Msg {
+		err := m.client.UpdateIssues(m.ctx, org, []glitchtip.ID{issue.ID}, update)
+		return issueUpdatedMsg{issue, change, err}
+	}
+}
+
+func (m model) scheduleRefresh() tea.Cmd {
+	if m.refresh == 0 {
+		return nil
+	}
+	gen := m.refreshGen
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg { return refreshMsg{gen} })
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.loading == 0 {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case organizationsMsg:
+		m.doneLoading()
+		if msg.err != nil {
+			m.fatal = msg.err
+			return m, tea.Quit
+		}
+		items := make([]list.Item, len(msg.orgs))
+		for i, org := range msg.orgs {
+			items[i] = organizationItem{org}
+		}
+		// With a single organization there is nothing to pick
+		if len(msg.orgs) == 1 {
+			m.org = msg.orgs[0].Slug
+			m.pickedOrg = false
+			m.screen = screenProjects
+			return m, m.startLoading(m.loadProjects())
+		}
+		return m, m.orgs.SetItems(items)
+
+	case projectsMsg:
+		m.doneLoading()
+		if msg.err != nil {
+			m.fatal = msg.err
+			return m, tea.Quit
+		}
+		items := []list.Item{projectItem{}}
+		for i := range msg.projects {
+			items = append(items, projectItem{&msg.projects[i]})
+			if msg.projects[i].Slug == m.projectSlug {
+				m.project = &msg.projects[i]
+			}
+		}
+		m.projects.Title = "Projects of " + m.org
+		cmd := m.projects.SetItems(items)
+		if m.projectSlug != "" {
+			if m.project == nil {
+				m.fatal = fmt.Errorf("project %q not found in organization %q", m.projectSlug, m.org)
+				return m, tea.Quit
+			}
+			// Only skip the picker on start up; esc still returns to it
+			m.projectSlug = ""
+			next, open := m.openIssues()
+			return next, tea.Batch(cmd, open)
+		}
+		return m, cmd
+
+	case issuesMsg:
+		m.doneLoading()
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.err = nil
+		m.updatedAt = time.Now()
+		return m, m.setIssues(msg.issues)
+
+	case eventMsg:
+		m.doneLoading()
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.events[msg.issueID] = msg.event
+		if issue, ok := m.selectedIssue(); ok && issue.ID.String() == msg.issueID {
+			m.showDetail(issue)
+		}
+		return m, nil
+
+	case issueUpdatedMsg:
+		m.doneLoading()
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		cmd := m.issues.NewStatusMessage(fmt.Sprintf("%s is now %s", msg.issue.ShortID, msg.change))
+		return m, tea.Batch(cmd, m.startLoading(m.loadIssues()))
+
+	case membersMsg:
+		m.doneLoading()
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.members[msg.org] = msg.members
+		if m.screen != screenAssignees || msg.org != m.org {
+			return m, nil
+		}
+		return m, m.setAssignees()
+
+	case refreshMsg:
+		// Ticks of a loop started by an earlier visit of the issue screen
+		// are dropped, so that only one loop polls
+		if msg.gen != m.refreshGen {
+			return m, nil
+		}
+		if m.screen != screenIssues {
+			return m, m.scheduleRefresh()
+		}
+		return m, tea.Batch(m.startLoading(m.loadIssues()), m.scheduleRefresh())
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+// handleKey routes a key press to the focused screen
+func (m model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	active := m.activeList()
+
+	// While a filter is typed or applied, keys belong to the list
+	if active.FilterState() == list.Filtering || (active.FilterState() == list.FilterApplied && key.Matches(msg, backKey)) {
+		return m.updateList(msg)
+	}
+	if key.Matches(msg, quitKey) {
+		return m, tea.Quit
+	}
+
+	switch m.screen {
+	case screenOrganizations:
+		if msg.String() == "enter" {
+			if item, ok := m.orgs.SelectedItem().(organizationItem); ok {
+				m.org = item.org.Slug
+				m.screen = screenProjects
+				m.projects.ResetFilter()
+				m.projects.Select(0)
+				return m, m.startLoading(m.loadProjects())
+			}
+		}
+
+	case screenProjects:
+		switch {
+		case msg.String() == "enter":
+			if item, ok := m.projects.SelectedItem().(projectItem); ok {
+				m.project = item.project
+				return m.openIssues()
+			}
+		case key.Matches(msg, backKey) && m.pickedOrg:
+			m.screen = screenOrganizations
+			return m, nil
+		}
+
+	case screenIssues:
+		switch {
+		case key.Matches(msg, backKey):
+			if m.focusDetail {
+				m.focusDetail = false
+				return m, nil
+			}
+			m.screen = screenProjects
+			m.refreshGen++
+			m.issues.SetItems(nil)
+			m.detail.SetContent("")
+			return m, nil
+		case key.Matches(msg, focusKey), msg.String() == "enter" && !m.focusDetail:
+			m.focusDetail = !m.focusDetail
+			return m, nil
+		case key.Matches(msg, refreshKey):
+			return m, m.startLoading(m.loadIssues())
+		case key.Matches(msg, resolveKey), key.Matches(msg, ignoreKey), key.Matches(msg, unresolveKey):
+			issue, ok := m.selectedIssue()
+			if !ok {
+				return m, nil
+			}
+			status := glitchtip.IssueStatusResolved
+			if key.Matches(msg, ignoreKey) {
+				status = glitchtip.IssueStatusIgnored
+			} else if key.Matches(msg, unresolveKey) {
+				status = glitchtip.IssueStatusUnresolved
+			}
+			return m, m.startLoading(m.updateIssue(issue, glitchtip.IssueUpdate{Status: status}, status))
+		case key.Matches(msg, assignKey):
+			issue, ok := m.selectedIssue()
+			if !ok {
+				return m, nil
+			}
+			m.assigning = issue
+			m.screen = screenAssignees
+			m.assignees.Title = "Assign " + issue.ShortID + " to"
+			m.assignees.ResetFilter()
+			if _, ok := m.members[m.org]; !ok {
+				m.assignees.SetItems(nil)
+				return m, m.startLoading(m.loadMembers())
+			}
+			return m, m.setAssignees()
+		}
+		if m.focusDetail {
+			var cmd tea.Cmd
+			m.detail, cmd = m.detail.Update(msg)
+			return m, cmd
+		}
+
+	case screenAssignees:
+		switch {
+		case msg.String() == "enter":
+			item, ok := m.assignees.SelectedItem().(assigneeItem)
+			if !ok {
+				return m, nil
+			}
+			m.screen = screenIssues
+			none := ""
+			update, change := glitchtip.IssueUpdate{AssignedTo: &none}, "unassigned"
+			if item.member != nil {
+				update.AssignedTo = glitchtip.AssignUser(item.member.User.ID)
+				change = "assigned to " + item.member.Email
+			}
+			return m, m.startLoading(m.updateIssue(m.assigning, update, change))
+		case key.Matches(msg, backKey):
+			m.screen = screenIssues
+			return m, nil
+		}
+	}
+	return m.updateList(msg)
+}
+
+// setAssignees fills the assignee picker with the members of the
+// organization who can be assigned issues, after an entry to unassign
+func (m *model) setAssignees() tea.Cmd {
+	items := []list.Item{assigneeItem{}}
+	members := m.members[m.org]
+	for i := range members {
+		// Invited users have no account yet
+		if members[i].User != nil {
+			items = append(items, assigneeItem{&members[i]})
+		}
+	}
+	cmd := m.assignees.SetItems(items)
+	m.assignees.Select(0)
+	return cmd
+}
+
+// updateList passes a message to the focused list and loads the latest
+// event when the selected issue changes
+func (m model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch m.screen {
+	case screenOrganizations:
+		m.orgs, cmd = m.orgs.Update(msg)
+	case screenProjects:
+		m.projects, cmd = m.projects.Update(msg)
+	case screenAssignees:
+		m.assignees, cmd = m.assignees.Update(msg)
+	case screenIssues:
+		before, _ := m.selectedIssue()
+		m.issues, cmd = m.issues.Update(msg)
+		if after, ok := m.selectedIssue(); ok && after.ID != before.ID {
+			return m, tea.Batch(cmd, m.selectIssue(after))
+		}
+	}
+	return m, cmd
+}
+
+func (m model) openIssues() (tea.Model, tea.Cmd) {
+	m.screen = screenIssues
+	m.focusDetail = false
+	m.issues.Title = "Issues of " + m.org
+	if m.project != nil {
+		m.issues.Title += "/" + m.project.Slug
+	}
+	m.issues.ResetFilter()
+	m.issues.Select(0)
+	m.resize()
+	m.refreshGen++
+	return m, tea.Batch(m.startLoading(m.loadIssues()), m.scheduleRefresh())
+}
+
+// setIssues replaces the issue list, keeping the selected issue selected
+// when it is still listed
+func (m *model) setIssues(issues []glitchtip.Issue) tea.Cmd {
+	selected, hadSelection := m.selectedIssue()
+
+	items := make([]list.Item, len(issues))
+	index := 0
+	for i, issue := range issues {
+		items[i] = issueItem{issue}
+		if hadSelection && issue.ID == selected.ID {
+			index = i
+		}
+	}
+	cmd := m.issues.SetItems(items)
+	if len(items) == 0 {
+		m.detail.SetContent("No issues match " + m.query)
+		return cmd
+	}
+	m.issues.Select(index)
+
+	issue, _ := m.selectedIssue()
+	return tea.Batch(cmd, m.selectIssue(issue))
+}
+
+// selectIssue shows an issue in the detail pane, loading its latest
+// event unless it was loaded before
+func (m *model) selectIssue(issue glitchtip.Issue) tea.Cmd {
+	m.showDetail(issue)
+	if _, ok := m.events[issue.ID.String()]; ok {
+		return nil
+	}
+	return m.startLoading(m.loadEvent(issue.ID.String()))
+}
+
+func (m model) selectedIssue() (glitchtip.Issue, bool) {
+	item, ok := m.issues.SelectedItem().(issueItem)
+	return item.issue, ok
+}
+
+// showDetail renders an issue and its latest event into the detail pane
+func (m *model) showDetail(issue glitchtip.Issue) {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "%s  %s  %s events  first seen %s", issue.ShortID, issue.Status, issue.Count, issue.FirstSeen)
+	if assignee := issuecmd.Assignee(issue); assignee != "" {
+		fmt.Fprintf(&buffer, "  assigned to %s", assignee)
+	}
+	buffer.WriteString("\n\n")
+	if latest, ok := m.events[issue.ID.String()]; ok {
+		if err := event.RenderEvent(&buffer, latest, event.RenderOptions{Breadcrumbs: 10}); err != nil {
+			fmt.Fprintf(&buffer, "\n%v\n", err)
+		}
+	} else {
+		buffer.WriteString("Loading the latest event...\n")
+	}
+	m.detail.SetContent(buffer.String())
+	m.detail.GotoTop()
+}
+
+func (m *model) activeList() *list.Model {
+	switch m.screen {
+	case screenOrganizations:
+		return &m.orgs
+	case screenProjects:
+		return &m.projects
+	case screenAssignees:
+		return &m.assignees
+	}
+	return &m.issues
+}
+
+// resize lays the panes out for the current window size
+func (m *model) resize() {
+	// One line for the header and one for errors
+	height := m.height - 2
+	if height < 0 {
+		height = 0
+	}
+	m.orgs.SetSize(m.width, height)
+	m.projects.SetSize(m.width, height)
+	m.assignees.SetSize(m.width, height)
+
+	// The issue list and detail pane share the width, each with a border
+	listWidth := m.width * 2 / 5
+	m.issues.SetSize(max(listWidth-2, 0), max(height-2, 0))
+	m.detail.Width = max(m.width-listWidth-2, 0)
+	m.detail.Height = max(height-2, 0)
+}
+
+func (m model) View() string {
+	header := "GlitchTip"
+	if m.org != "" {
+		header += " · " + m.org
+	}
+	if m.screen == screenIssues || m.screen == screenAssignees {
+		if m.project != nil {
+			header += "/" + m.project.Slug
+		}
+		header += " · " + m.query
+		if !m.updatedAt.IsZero() {
+			header += " · updated " + m.updatedAt.Format("15:04:05")
+		}
+	}
+	if m.loading > 0 {
+		header += " " + m.spinner.View()
+	}
+
+	var body string
+	switch m.screen {
+	case screenOrganizations:
+		body = m.orgs.View()
+	case screenProjects:
+		body = m.projects.View()
+	case screenAssignees:
+		body = m.assignees.View()
+	default:
+		listStyle, detailStyle := focusedStyle, paneStyle
+		if m.focusDetail {
+			listStyle, detailStyle = paneStyle, focusedStyle
+		}
+		body = lipgloss.JoinHorizontal(lipgloss.Top,
+			listStyle.Render(m.issues.View()),
+			detailStyle.Render(m.detail.View()))
+	}
+
+	footer := ""
+	if m.err != nil {
+		footer = errorStyle.Render(m.err.Error())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, headerStyle.Render(header), body, footer)
+}
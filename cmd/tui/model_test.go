@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestStaleRefreshTicksAreDropped(t *testing.T) {
+	m := newModel(glitchtip.NewClient("http://localhost", "token"), "acme", "", "is:unresolved", time.Minute)
+
+	opened, _ := m.openIssues()
+	first := opened.(model).refreshGen
+	left, _ := opened.(model).handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+	reopened, _ := left.(model).openIssues()
+
+	if _, cmd := reopened.Update(refreshMsg{first}); cmd != nil {
+		t.Error("a tick of the first visit was handled after the issue screen was reopened")
+	}
+	if _, cmd := reopened.Update(refreshMsg{reopened.(model).refreshGen}); cmd == nil {
+		t.Error("a tick of the current visit was dropped")
+	}
+}
+
+func TestAssigneesSkipInvitedMembers(t *testing.T) {
+	m := newModel(glitchtip.NewClient("http://localhost", "token"), "acme", "", "", 0)
+	m.screen = screenAssignees
+	updated, _ := m.Update(membersMsg{org: "acme", members: []glitchtip.Member{
+		{Email: "ann@example.com", User: &glitchtip.User{ID: "3"}},
+		{Email: "bob@example.com", Pending: true},
+	}})
+
+	items := updated.(model).assignees.Items()
+	if len(items) != 2 || items[0].(assigneeItem).member != nil || items[1].(assigneeItem).member.Email != "ann@example.com" {
+		t.Errorf("assignees = %v, want the unassign entry and ann", items)
+	}
+}
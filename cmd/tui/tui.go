@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tuiOrg     string
+	tuiProject string
+	tuiQuery   string
+	tuiRefresh time.Duration
+)
+
+// TuiCmd represents the tui command
+var TuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse and triage issues in a full-screen terminal UI",
+	Long: `Browse and triage issues in a full-screen terminal UI.
+
+Pick an organization and a project, then browse their issues next to the stack trace of each
+issue's latest event. The issue list refreshes periodically, so it can be left open on a
+second monitor.
+
+Keys:
+  enter      select the organization or project, or focus the detail pane
+  tab        switch between the issue list and the detail pane
+  /          filter the list
+  r, i, u    resolve, ignore or unresolve the selected issue
+  a          assign the selected issue to a member, or unassign it
+  ctrl+r     refresh now
+  esc        go back to the previous picker
+  q, ctrl+c  quit`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !common.IsInteractive() {
+			return common.Validationf("the tui command needs an interactive terminal")
+		}
+		if tuiRefresh < 0 {
+			return common.Validationf("--refresh must not be negative")
+		}
+		client, err := common.NewClient()
+		if err != nil {
+			return err
+		}
+
+		// The org picker is skipped when an organization is known
+		org := tuiOrg
+		if org == "" {
+			org, _ = common.ResolveOrganization("")
+		}
+
+		program := tea.NewProgram(newModel(client, org, tuiProject, tuiQuery, tuiRefresh), tea.WithAltScreen())
+		final, err := program.Run()
+		if err != nil {
+			return err
+		}
+		return final.(model).fatal
+	},
+}
+
+func init() {
+	TuiCmd.Flags().StringVar(&tuiOrg, "org", "", "Organization slug (defaults to the context organization, otherwise a picker is shown)")
+	TuiCmd.Flags().StringVarP(&tuiProject, "project", "p", "", "Project slug (a picker is shown when not set)")
+	TuiCmd.Flags().StringVar(&tuiQuery, "query", "is:unresolved", "Search query in GlitchTip syntax")
+	TuiCmd.Flags().DurationVar(&tuiRefresh, "refresh", 30*time.Second, "How often the issue list is refreshed (0 disables refreshing)")
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nanyte25/glitchtipctl/common"
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// NewGetCmd creates the command that lists the users of an organization
+func NewGetCmd() *cobra.Command {
+	var orgFlag string
+
+	cmd := &cobra.Command{
+		Use:     "users [organization_slug]",
+		Aliases: []string{"user"},
+		Short:   "Fetch the users of an organization",
+		Long:    `Fetch and display the users of a specified organization by passing its slug.`,
+		Args:    cobra.MaximumNArgs(1), // The org slug defaults to the context organization
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := common.OutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts, err := common.ListOptions(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := common.NewClient()
+			if err != nil {
+				return err
+			}
+
+			// Use the passed slug or fall back to the context organization
+			if len(args) > 0 {
+				if orgFlag != "" && orgFlag != args[0] {
+					return common.Validationf("the organization was given both as an argument and with --org")
+				}
+				orgFlag = args[0]
+			}
+			orgSlug, err := common.ResolveOrganization(orgFlag)
+			if err != nil {
+				return err
+			}
+
+			// Fetch behind a spinner, then print once the spinner is gone
+			users, err := common.FetchWithSpinner("Fetching users...", func() ([]glitchtip.User, error) {
+				return client.ListOrganizationUsers(context.Background(), orgSlug, opts)
+			})
+			if err != nil {
+				return err
+			}
+			return userPrinter.PrintList(os.Stdout, format, users)
+		},
+	}
+
+	common.AddOrgFlag(cmd, &orgFlag)
+	common.AddOutputFlag(cmd)
+	common.AddListFlags(cmd)
+	return cmd
+}
+
+// userPrinter prints user accounts in every output format
+var userPrinter = common.ResourcePrinter[glitchtip.User]{
+	Kind: "user",
+	Name: func(user glitchtip.User) string { return user.Email },
+	Columns: []common.Column[glitchtip.User]{
+		{Header: "ID", Value: func(user glitchtip.User) string { return user.ID.String() }},
+		{Header: "Name", Value: func(user glitchtip.User) string { return user.Name }},
+		{Header: "Email", Value: func(user glitchtip.User) string { return user.Email }},
+		{Header: "Username", Wide: true, Value: func(user glitchtip.User) string { return user.Username }},
+		{Header: "Active", Wide: true, Value: func(user glitchtip.User) string { return fmt.Sprintf("%t", user.IsActive) }},
+		{Header: "Last Login", Wide: true, Value: func(user glitchtip.User) string { return user.LastLogin }},
+	},
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/cmd/alert"
+	"github.com/nanyte25/glitchtipctl/cmd/event"
+	"github.com/nanyte25/glitchtipctl/cmd/issue"
+	"github.com/nanyte25/glitchtipctl/cmd/key"
+	"github.com/nanyte25/glitchtipctl/cmd/member"
+	"github.com/nanyte25/glitchtipctl/cmd/monitor"
+	"github.com/nanyte25/glitchtipctl/cmd/organization"
+	"github.com/nanyte25/glitchtipctl/cmd/project"
+	"github.com/nanyte25/glitchtipctl/cmd/release"
+	"github.com/nanyte25/glitchtipctl/cmd/team"
+	"github.com/nanyte25/glitchtipctl/cmd/user"
+	"github.com/spf13/cobra"
+)
+
+// getCmd represents the get command
+var getCmd = &cobra.Command{
+	Use:   "get <resource>",
+	Short: "List resources",
+	Long: `List resources such as organizations, teams, projects, members and issues.
+
+Resources accept their singular and short names as well, e.g. "get org" or "get proj".`,
+}
+
+// createCmd represents the create command
+var createCmd = &cobra.Command{
+	Use:   "create <resource>",
+	Short: "Create a resource",
+}
+
+// describeCmd represents the describe command
+var describeCmd = &cobra.Command{
+	Use:   "describe <resource>",
+	Short: "Show a resource in detail",
+	Long:  `Show a resource in detail, together with the objects related to it.`,
+}
+
+// updateCmd represents the update command
+var updateCmd = &cobra.Command{
+	Use:   "update <resource>",
+	Short: "Change the settings of a resource",
+	Long: `Change the settings of a resource. Only the given settings are sent, and the changed fields are
+printed with their old and new values. Every update command accepts --dry-run.`,
+}
+
+// deleteCmd represents the delete command
+var deleteCmd = &cobra.Command{
+	Use:   "delete <resource>",
+	Short: "Delete a resource",
+	Long: `Delete a resource. Every delete command accepts --dry-run to print what would be removed, and asks
+for confirmation unless --yes is given.`,
+}
+
+// deprecatedAlias turns cmd into a hidden command under the old camelCase
+// name, which prints a pointer to its replacement when used
+func deprecatedAlias(cmd *cobra.Command, name, replacement string) *cobra.Command {
+	// Keep the argument part of the usage line
+	if _, args, ok := strings.Cut(cmd.Use, " "); ok {
+		name += " " + args
+	}
+	cmd.Use = name
+	cmd.Aliases = nil
+	cmd.Hidden = true
+	cmd.Deprecated = "use \"glitchtipctl " + replacement + "\" instead."
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(getCmd, createCmd, describeCmd, updateCmd, deleteCmd)
+
+	getCmd.AddCommand(
+		organization.NewGetCmd(),
+		team.NewGetCmd(),
+		project.NewGetCmd(),
+		member.NewGetCmd(),
+		user.NewGetCmd(),
+		issue.NewGetCmd(),
+		event.NewGetCmd(),
+		key.NewGetCmd(),
+		release.NewGetCmd(),
+		monitor.NewGetCmd(),
+		alert.NewGetCmd(),
+	)
+	createCmd.AddCommand(
+		organization.NewCreateCmd(),
+		team.NewCreateCmd(),
+		project.NewCreateCmd(),
+		key.NewCreateCmd(),
+		release.NewCreateCmd(),
+		monitor.NewCreateCmd(),
+		alert.NewCreateCmd(),
+	)
+	describeCmd.AddCommand(
+		organization.NewDescribeCmd(),
+		team.NewDescribeCmd(),
+		project.NewDescribeCmd(),
+	)
+	updateCmd.AddCommand(
+		organization.NewUpdateCmd(),
+		team.NewUpdateCmd(),
+		project.NewUpdateCmd(),
+	)
+	deleteCmd.AddCommand(
+		organization.NewDeleteCmd(),
+		team.NewDeleteCmd(),
+		project.NewDeleteCmd(),
+		member.NewDeleteCmd(),
+		issue.NewDeleteCmd(),
+		key.NewDeleteCmd(),
+		release.NewDeleteCmd(),
+		monitor.NewDeleteCmd(),
+		alert.NewDeleteCmd(),
+	)
+
+	// The camelCase commands of earlier releases keep working for now
+	rootCmd.AddCommand(
+		deprecatedAlias(organization.NewGetCmd(), "getOrganizations", "get organizations"),
+		deprecatedAlias(team.NewGetCmd(), "getTeams", "get teams"),
+		deprecatedAlias(project.NewGetCmd(), "getProjects", "get projects"),
+		deprecatedAlias(member.NewGetCmd(), "getMembers", "get members"),
+		deprecatedAlias(user.NewGetCmd(), "getUsers", "get users"),
+		deprecatedAlias(organization.NewCreateCmd(), "createOrganization", "create organization"),
+		deprecatedAlias(team.NewCreateCmd(), "createTeam", "create team"),
+		deprecatedAlias(project.NewCreateCmd(), "createProject", "create project"),
+		deprecatedAlias(organization.NewDeleteCmd(), "deleteOrganization", "delete organization"),
+		deprecatedAlias(team.NewDeleteCmd(), "deleteTeam", "delete team"),
+		deprecatedAlias(project.NewDeleteCmd(), "deleteProject", "delete project"),
+		deprecatedAlias(member.NewDeleteCmd(), "deleteUser", "delete member"),
+	)
+}
@@ -0,0 +1,171 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ActiveContext resolves the context commands should use. The --context
+// flag wins over the config file's current context, and the GLITCHTIP_URL
+// and GLITCHTIP_API_TOKEN environment variables override the values it
+// holds. Without any config file the environment alone is used.
+func ActiveContext() (*Context, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	name := config.ActiveContextName()
+
+	ctx := &Context{}
+	if name != "" {
+		found := config.Context(name)
+		if found == nil {
+			return nil, Validationf("context %q does not exist", name)
+		}
+		*ctx = *found
+
+		// Tokens saved by login live in the keyring or credentials file;
+		// a token written directly into the config file still wins.
+		if ctx.Token == "" {
+			if ctx.Token, err = LoadToken(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if url := os.Getenv("GLITCHTIP_URL"); url != "" {
+		ctx.URL = url
+	}
+	if token := os.Getenv("GLITCHTIP_API_TOKEN"); token != "" {
+		ctx.Token = token
+	}
+	return ctx, nil
+}
+
+// NewClient creates a GlitchTip API client for the active context.
+func NewClient() (*glitchtip.Client, error) {
+	ctx, err := ActiveContext()
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Token == "" {
+		return nil, fmt.Errorf("%w: run 'glitchtipctl login' or set GLITCHTIP_API_TOKEN", ErrNotLoggedIn)
+	}
+	return glitchtip.NewClient(ctx.URL, ctx.Token), nil
+}
+
+// AddOrgFlag registers the --org flag of a command that works within one
+// organization. The older --organization spelling is accepted as well.
+func AddOrgFlag(cmd *cobra.Command, org *string) {
+	addOrgFlag(cmd, org, "")
+}
+
+// AddOrgFlagP is AddOrgFlag with the -o shorthand, for the commands that
+// had it before -o meant --output. They have no --output flag.
+func AddOrgFlagP(cmd *cobra.Command, org *string) {
+	addOrgFlag(cmd, org, "o")
+}
+
+func addOrgFlag(cmd *cobra.Command, org *string, shorthand string) {
+	cmd.Flags().StringVarP(org, "org", shorthand, "", "Organization slug (defaults to the context organization)")
+	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "organization" {
+			name = "org"
+		}
+		return pflag.NormalizedName(name)
+	})
+}
+
+// ResolveOrganization returns orgSlug when set and otherwise falls back to
+// the default organization of the active context.
+func ResolveOrganization(orgSlug string) (string, error) {
+	if orgSlug != "" {
+		return orgSlug, nil
+	}
+	ctx, err := ActiveContext()
+	if err != nil {
+		return "", err
+	}
+	if ctx.Organization == "" {
+		return "", Validationf("no organization given and the current context has no default organization")
+	}
+	return ctx.Organization, nil
+}
+
+// ResolveScopedSlug splits an "org/slug" argument into its organization
+// and slug. A bare slug belongs to the organization of --org, or to the
+// default organization of the active context.
+func ResolveScopedSlug(arg, orgFlag string) (string, string, error) {
+	orgSlug, slug, scoped := strings.Cut(arg, "/")
+	if !scoped {
+		orgSlug, err := ResolveOrganization(orgFlag)
+		return orgSlug, arg, err
+	}
+	if orgSlug == "" || slug == "" || strings.Contains(slug, "/") {
+		return "", "", Validationf("%q is not of the form <organization>/<slug>", arg)
+	}
+	if orgFlag != "" && orgFlag != orgSlug {
+		return "", "", Validationf("%q belongs to organization %q, but --org is %q", arg, orgSlug, orgFlag)
+	}
+	return orgSlug, slug, nil
+}
+
+// SaveLogin stores the server URL of the active context and saves the token
+// in the keyring or credentials file. When no context exists yet a "default"
+// context is created and made current. It returns the name of the context
+// that was written and where the token was stored.
+func SaveLogin(url, token string) (string, TokenStore, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return "", "", err
+	}
+
+	name := config.ActiveContextName()
+	if name == "" {
+		name = DefaultContextName
+	}
+	ctx := Context{Name: name}
+	if existing := config.Context(name); existing != nil {
+		ctx = *existing
+	}
+	ctx.URL = url
+	ctx.Token = ""
+
+	store, err := StoreToken(name, token)
+	if err != nil {
+		return "", "", err
+	}
+
+	config.SetContext(ctx)
+	if config.CurrentContext == "" {
+		config.CurrentContext = name
+	}
+	return name, store, config.Save()
+}
+
+// ClearLogin removes the token of the active context from the config file,
+// the keyring and the credentials file, and returns the name of that
+// context.
+func ClearLogin() (string, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return "", err
+	}
+
+	name := config.ActiveContextName()
+	ctx := config.Context(name)
+	if ctx == nil {
+		return "", fmt.Errorf("%w: no context is configured", ErrNotLoggedIn)
+	}
+	ctx.Token = ""
+	if err := DeleteToken(name); err != nil {
+		return "", err
+	}
+	return name, config.Save()
+}
@@ -0,0 +1,132 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the on-disk glitchtipctl configuration. It holds any number of
+// named contexts, each pointing at a GlitchTip server.
+type Config struct {
+	CurrentContext string    `yaml:"current-context"`
+	Contexts       []Context `yaml:"contexts"`
+}
+
+// Context groups the server URL, API token and default organization used
+// when talking to one GlitchTip instance.
+type Context struct {
+	Name         string `yaml:"name" json:"name"`
+	URL          string `yaml:"url" json:"url"`
+	Token        string `yaml:"token,omitempty" json:"-"`
+	Organization string `yaml:"organization,omitempty" json:"organization,omitempty"`
+}
+
+// ConfigPath returns the location of the config file. GLITCHTIPCTL_CONFIG
+// overrides the default of $XDG_CONFIG_HOME/glitchtipctl/config.yaml.
+func ConfigPath() (string, error) {
+	if path := os.Getenv("GLITCHTIPCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("error locating config directory: %w", err)
+	}
+	return filepath.Join(configDir, "glitchtipctl", "config.yaml"), nil
+}
+
+// LoadConfig reads the config file. A missing file yields an empty config.
+func LoadConfig() (*Config, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+// Save writes the config file, creating its directory if needed. The file
+// is only readable by the current user because it may contain tokens.
+func (c *Config) Save() error {
+	path, err := ConfigPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return fmt.Errorf("error encoding config: %w", err)
+	}
+	if err := os.WriteFile(path, buffer.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("error writing config file: %w", err)
+	}
+	return nil
+}
+
+// DefaultContextName names the context created when logging in without
+// any configured context.
+const DefaultContextName = "default"
+
+// ActiveContextName returns the context selected by the --context flag, or
+// the current context when the flag is not set.
+func (c *Config) ActiveContextName() string {
+	if Options.Context != "" {
+		return Options.Context
+	}
+	return c.CurrentContext
+}
+
+// Context returns the named context, or nil if it does not exist.
+func (c *Config) Context(name string) *Context {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i]
+		}
+	}
+	return nil
+}
+
+// SetContext adds the context or replaces an existing one with the same
+// name.
+func (c *Config) SetContext(ctx Context) {
+	if existing := c.Context(ctx.Name); existing != nil {
+		*existing = ctx
+		return
+	}
+	c.Contexts = append(c.Contexts, ctx)
+}
+
+// DeleteContext removes the named context and reports whether it existed.
+func (c *Config) DeleteContext(name string) bool {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			c.Contexts = append(c.Contexts[:i], c.Contexts[i+1:]...)
+			if c.CurrentContext == name {
+				c.CurrentContext = ""
+			}
+			return true
+		}
+	}
+	return false
+}
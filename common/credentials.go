@@ -0,0 +1,162 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+	"gopkg.in/yaml.v3"
+)
+
+// keyringService is the service name tokens are stored under in the OS
+// keyring. The account name is the context name.
+const keyringService = "glitchtipctl"
+
+// TokenStore describes where a token ended up being stored.
+type TokenStore string
+
+const (
+	// StoreKeyring is the OS keyring (Secret Service, macOS Keychain or
+	// Windows Credential Manager).
+	StoreKeyring TokenStore = "keyring"
+	// StoreFile is the credentials file next to the config file, readable
+	// only by the current user.
+	StoreFile TokenStore = "file"
+)
+
+// StoreToken saves the token of a context in the OS keyring, falling back
+// to the credentials file when no keyring is available.
+func StoreToken(contextName, token string) (TokenStore, error) {
+	if os.Getenv("GLITCHTIPCTL_NO_KEYRING") == "" {
+		if err := keyring.Set(keyringService, contextName, token); err == nil {
+			// Drop any stale copy left in the file by an earlier fallback.
+			_ = deleteFileToken(contextName)
+			return StoreKeyring, nil
+		}
+	}
+
+	if err := storeFileToken(contextName, token); err != nil {
+		return "", err
+	}
+	return StoreFile, nil
+}
+
+// LoadToken returns the stored token of a context, or an empty string when
+// none is stored.
+func LoadToken(contextName string) (string, error) {
+	if os.Getenv("GLITCHTIPCTL_NO_KEYRING") == "" {
+		if token, err := keyring.Get(keyringService, contextName); err == nil {
+			return token, nil
+		}
+	}
+
+	tokens, err := loadFileTokens()
+	if err != nil {
+		return "", err
+	}
+	return tokens[contextName], nil
+}
+
+// DeleteToken removes the token of a context from every store.
+func DeleteToken(contextName string) error {
+	// The keyring may be unavailable or hold nothing for this context;
+	// either way the file copy must still be removed.
+	_ = keyring.Delete(keyringService, contextName)
+	return deleteFileToken(contextName)
+}
+
+// ReadTokenFromStdin reads a token piped on stdin, so that it never appears
+// in shell history or the process list.
+func ReadTokenFromStdin() (string, error) {
+	return ReadSecretFromStdin("token")
+}
+
+// ReadSecretFromStdin reads a secret such as a token or password piped on
+// stdin. What names the secret in error messages.
+func ReadSecretFromStdin(what string) (string, error) {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s from stdin: %w", what, err)
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", fmt.Errorf("no %s was given on stdin", what)
+	}
+	return secret, nil
+}
+
+// credentialsPath is the fallback token file, stored beside the config.
+func credentialsPath() (string, error) {
+	configPath, err := ConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "credentials.yaml"), nil
+}
+
+func loadFileTokens() (map[string]string, error) {
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials file: %w", err)
+	}
+
+	tokens := map[string]string{}
+	if err := yaml.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("error parsing credentials file %s: %w", path, err)
+	}
+	return tokens, nil
+}
+
+func saveFileTokens(tokens map[string]string) error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating config directory: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := yaml.NewEncoder(&buffer).Encode(tokens); err != nil {
+		return fmt.Errorf("error encoding credentials: %w", err)
+	}
+	if err := os.WriteFile(path, buffer.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("error writing credentials file: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file, so tighten it explicitly.
+	return os.Chmod(path, 0o600)
+}
+
+func storeFileToken(contextName, token string) error {
+	tokens, err := loadFileTokens()
+	if err != nil {
+		return err
+	}
+	tokens[contextName] = token
+	return saveFileTokens(tokens)
+}
+
+func deleteFileToken(contextName string) error {
+	tokens, err := loadFileTokens()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[contextName]; !ok {
+		return nil
+	}
+	delete(tokens, contextName)
+	return saveFileTokens(tokens)
+}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// maxSummaryNames caps the names listed by Summarize.
+const maxSummaryNames = 10
+
+// AddDeleteFlags registers the --yes and --dry-run flags of a destructive
+// command.
+func AddDeleteFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+	cmd.Flags().Bool("dry-run", false, "Only print what would be deleted")
+}
+
+// DeleteFlags returns the values of the --dry-run and --yes flags.
+func DeleteFlags(cmd *cobra.Command) (dryRun, yes bool) {
+	dryRun, _ = cmd.Flags().GetBool("dry-run")
+	yes, _ = cmd.Flags().GetBool("yes")
+	return dryRun, yes
+}
+
+// DeletionPlan describes a resource about to be deleted and what goes
+// with it.
+type DeletionPlan struct {
+	// Kind is the kind of resource, e.g. "project".
+	Kind string
+	// Name is the slug or email the user types again to confirm.
+	Name string
+	// Title is a longer description, e.g. the display name.
+	Title string
+	// Details lists related objects removed or affected as well.
+	Details []string
+}
+
+// Print writes the plan, starting with verb, e.g. "Would delete".
+func (p *DeletionPlan) Print(w io.Writer, verb string) {
+	fmt.Fprintf(w, "%s %s %q", verb, p.Kind, p.Name)
+	if p.Title != "" && p.Title != p.Name {
+		fmt.Fprintf(w, " (%s)", p.Title)
+	}
+	fmt.Fprintln(w)
+	for _, detail := range p.Details {
+		fmt.Fprintf(w, "  %s\n", detail)
+	}
+}
+
+// RunDeletion looks up what a delete command would remove, prints it for
+// --dry-run, asks for confirmation unless --yes is set and then deletes.
+func RunDeletion(cmd *cobra.Command, describe func(ctx context.Context) (*DeletionPlan, error), del func(ctx context.Context) error) error {
+	dryRun, yes := DeleteFlags(cmd)
+	ctx := context.Background()
+
+	plan, err := FetchWithSpinner("Looking up what would be deleted...", func() (*DeletionPlan, error) {
+		return describe(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		plan.Print(os.Stdout, "Would delete")
+		return nil
+	}
+
+	if !yes && IsInteractive() {
+		plan.Print(os.Stderr, "This will permanently delete")
+	}
+	if err := ConfirmDeletion(plan.Kind, plan.Name, yes); err != nil {
+		return err
+	}
+
+	_, err = FetchWithSpinner(fmt.Sprintf("Deleting %s %s...", plan.Kind, plan.Name), func() (struct{}, error) {
+		return struct{}{}, del(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s %q: %w", plan.Kind, plan.Name, err)
+	}
+	Infof("Deleted %s %q.", plan.Kind, plan.Name)
+	return nil
+}
+
+// ConfirmDeletion asks the user to type name again before a resource is
+// deleted. It returns nil without asking when yes is set, and refuses to
+// delete anything when there is no terminal to ask on.
+func ConfirmDeletion(kind, name string, yes bool) error {
+	if yes {
+		return nil
+	}
+	if !IsInteractive() {
+		return Validationf("refusing to delete %s %q without --yes in a non-interactive session", kind, name)
+	}
+	answer, err := Prompt(fmt.Sprintf("Type %q to confirm deleting the %s", name, kind), "")
+	if err != nil {
+		return err
+	}
+	if answer != name {
+		return fmt.Errorf("confirmation did not match %q, nothing was deleted", name)
+	}
+	return nil
+}
+
+// Summarize formats a count and the names of related objects for a
+// deletion plan, e.g. "2 teams: backend, ops".
+func Summarize(singular, plural string, names []string) string {
+	if len(names) == 0 {
+		return "no " + plural
+	}
+	noun := plural
+	if len(names) == 1 {
+		noun = singular
+	}
+	shown := names
+	if len(shown) > maxSummaryNames {
+		shown = shown[:maxSummaryNames]
+	}
+	line := fmt.Sprintf("%d %s: %s", len(names), noun, strings.Join(shown, ", "))
+	if len(names) > len(shown) {
+		line += fmt.Sprintf(" and %d more", len(names)-len(shown))
+	}
+	return line
+}
@@ -0,0 +1,34 @@
+package common
+
+import "testing"
+
+func TestConfirmDeletion(t *testing.T) {
+	if err := ConfirmDeletion("project", "web", true); err != nil {
+		t.Errorf("ConfirmDeletion with --yes = %v, want nil", err)
+	}
+	// go test does not run with a terminal on stdin
+	if IsInteractive() {
+		t.Skip("stdin is a terminal")
+	}
+	err := ConfirmDeletion("project", "web", false)
+	if err == nil || ExitCode(err) != ExitValidation {
+		t.Errorf("ConfirmDeletion without a terminal = %v, want a validation error", err)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{nil, "no teams"},
+		{[]string{"ops"}, "1 team: ops"},
+		{[]string{"ops", "web"}, "2 teams: ops, web"},
+		{[]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}, "12 teams: a, b, c, d, e, f, g, h, i, j and 2 more"},
+	}
+	for _, tt := range tests {
+		if got := Summarize("team", "teams", tt.names); got != tt.want {
+			t.Errorf("Summarize(%v) = %q, want %q", tt.names, got, tt.want)
+		}
+	}
+}
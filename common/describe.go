@@ -0,0 +1,103 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// none is printed for empty values in descriptions.
+const none = "<none>"
+
+// DescriptionWriter renders the indented "Label: value" view printed by
+// the describe commands. Values and table rows are aligned in columns.
+type DescriptionWriter struct {
+	tw *tabwriter.Writer
+	// rows is set while table rows are written, which are aligned
+	// separately from the fields that follow them.
+	rows bool
+}
+
+// NewDescriptionWriter creates a DescriptionWriter that writes to w. Call
+// Flush once everything is written.
+func NewDescriptionWriter(w io.Writer) *DescriptionWriter {
+	return &DescriptionWriter{tw: tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)}
+}
+
+// Field writes "label: value" at the given indentation level.
+func (d *DescriptionWriter) Field(level int, label string, value interface{}) {
+	d.endRows()
+	text := fmt.Sprint(value)
+	if text == "" {
+		text = none
+	}
+	fmt.Fprintf(d.tw, "%s%s:\t%s\n", indent(level), label, text)
+}
+
+// Section writes a heading for the rows that follow. An empty section
+// is printed as "title: <none>".
+func (d *DescriptionWriter) Section(level int, title string, empty bool) {
+	d.endRows()
+	if empty {
+		d.Field(level, title, "")
+		return
+	}
+	fmt.Fprintf(d.tw, "%s%s:\n", indent(level), title)
+}
+
+// Row writes one line of a table at the given indentation level.
+func (d *DescriptionWriter) Row(level int, cells ...string) {
+	d.rows = true
+	values := make([]string, len(cells))
+	for i, cell := range cells {
+		values[i] = cell
+		if cell == "" {
+			values[i] = none
+		}
+	}
+	fmt.Fprintf(d.tw, "%s%s\n", indent(level), strings.Join(values, "\t"))
+}
+
+// Flush writes the aligned output.
+func (d *DescriptionWriter) Flush() error {
+	return d.tw.Flush()
+}
+
+// endRows flushes the rows of a table so that they do not share column
+// widths with the next field.
+func (d *DescriptionWriter) endRows() {
+	if d.rows {
+		d.tw.Flush()
+		d.rows = false
+	}
+}
+
+func indent(level int) string {
+	return strings.Repeat("  ", level)
+}
+
+// sparkBars are the block characters Sparkline draws with, lowest first.
+var sparkBars = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline draws values as a row of bars scaled to the largest value.
+// Only zero is drawn with the lowest bar, so that small counts stay
+// visible.
+func Sparkline(values []int) string {
+	max := 0
+	for _, value := range values {
+		if value > max {
+			max = value
+		}
+	}
+
+	var b strings.Builder
+	for _, value := range values {
+		index := 0
+		if max > 0 && value > 0 {
+			index = 1 + value*(len(sparkBars)-2)/max
+		}
+		b.WriteRune(sparkBars[index])
+	}
+	return b.String()
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDescriptionWriter(t *testing.T) {
+	var out bytes.Buffer
+	d := NewDescriptionWriter(&out)
+	d.Field(0, "Name", "Web")
+	d.Field(0, "Platform", "")
+	d.Section(0, "Keys", false)
+	d.Row(1, "Default", "true", "https://key@example.com/1")
+	d.Section(0, "Environments", true)
+	if err := d.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lines without a value end a block of aligned columns
+	want := "Name:      Web\n" +
+		"Platform:  <none>\n" +
+		"Keys:\n" +
+		"  Default  true  https://key@example.com/1\n" +
+		"Environments:  <none>\n"
+	if out.String() != want {
+		t.Errorf("output =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   string
+	}{
+		{nil, ""},
+		{[]int{0, 0}, "▁▁"},
+		{[]int{0, 1, 2, 4, 8}, "▁▂▃▅█"},
+	}
+	for _, tt := range tests {
+		if got := Sparkline(tt.values); got != tt.want {
+			t.Errorf("Sparkline(%v) = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestResolveScopedSlug(t *testing.T) {
+	org, slug, err := ResolveScopedSlug("acme/web", "")
+	if err != nil || org != "acme" || slug != "web" {
+		t.Errorf("ResolveScopedSlug(acme/web) = %q, %q, %v", org, slug, err)
+	}
+	org, slug, err = ResolveScopedSlug("web", "acme")
+	if err != nil || org != "acme" || slug != "web" {
+		t.Errorf("ResolveScopedSlug(web, --org acme) = %q, %q, %v", org, slug, err)
+	}
+	for _, arg := range []string{"acme/", "/web", "acme/web/x"} {
+		if _, _, err := ResolveScopedSlug(arg, ""); ExitCode(err) != ExitValidation {
+			t.Errorf("ResolveScopedSlug(%q) = %v, want a validation error", arg, err)
+		}
+	}
+	if _, _, err := ResolveScopedSlug("acme/web", "other"); ExitCode(err) != ExitValidation {
+		t.Errorf("ResolveScopedSlug with a conflicting --org = %v, want a validation error", err)
+	}
+}
@@ -0,0 +1,50 @@
+package common
+
+import (
+	"io"
+)
+
+// Change is a field whose value differs between two versions of a
+// resource.
+type Change struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// Diff lists the fields whose value differs between before and after, in
+// the order of fields. The column headers name the fields.
+func Diff[T any](fields []Column[T], before, after T) []Change {
+	var changes []Change
+	for _, field := range fields {
+		if from, to := field.Value(before), field.Value(after); from != to {
+			changes = append(changes, Change{Field: field.Header, Before: from, After: to})
+		}
+	}
+	return changes
+}
+
+// PrintChanges writes changes as a table of before and after values.
+func PrintChanges(w io.Writer, changes []Change) {
+	table := NewTable(w, []string{"Field", "Before", "After"})
+	for _, change := range changes {
+		table.Append([]string{change.Field, change.Before, change.After})
+	}
+	table.Render()
+}
+
+// PrintUpdate writes the result of an update: the changed fields as a
+// before/after table in the default table format, and the updated resource
+// in every other output format.
+func (p ResourcePrinter[T]) PrintUpdate(w io.Writer, format string, fields []Column[T], before, after T) error {
+	if format != "table" {
+		return p.PrintObject(w, format, after)
+	}
+	changes := Diff(fields, before, after)
+	if len(changes) == 0 {
+		Infof("No changes to %s %s", p.Kind, p.Name(after))
+		return nil
+	}
+	PrintChanges(w, changes)
+	return nil
+}
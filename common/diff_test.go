@@ -0,0 +1,46 @@
+package common
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type item struct{ Name, Slug, Platform string }
+	fields := []Column[item]{
+		{Header: "name", Value: func(i item) string { return i.Name }},
+		{Header: "slug", Value: func(i item) string { return i.Slug }},
+		{Header: "platform", Value: func(i item) string { return i.Platform }},
+	}
+
+	before := item{Name: "Web", Slug: "web", Platform: "python"}
+	after := item{Name: "Website", Slug: "web", Platform: ""}
+	want := []Change{
+		{Field: "name", Before: "Web", After: "Website"},
+		{Field: "platform", Before: "python", After: ""},
+	}
+	if got := Diff(fields, before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff = %+v, want %+v", got, want)
+	}
+	if got := Diff(fields, before, before); got != nil {
+		t.Errorf("Diff of equal items = %+v, want none", got)
+	}
+
+	var out bytes.Buffer
+	printer := ResourcePrinter[item]{Kind: "item", Name: func(i item) string { return i.Slug }}
+	if err := printer.PrintUpdate(&out, "table", fields, before, after); err != nil {
+		t.Fatal(err)
+	}
+	if table := out.String(); !strings.Contains(table, "Website") || strings.Contains(table, "slug") {
+		t.Errorf("PrintUpdate table =\n%s", table)
+	}
+	out.Reset()
+	if err := printer.PrintUpdate(&out, "name", fields, before, after); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "item/web\n" {
+		t.Errorf("PrintUpdate -o name = %q", out.String())
+	}
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+// Exit codes returned by glitchtipctl, so scripts can tell failures apart.
+const (
+	// ExitOK means the command succeeded.
+	ExitOK = 0
+	// ExitError is any failure not covered by a more specific code.
+	ExitError = 1
+	// ExitValidation means invalid arguments or flags, or a 400/422
+	// response from the server.
+	ExitValidation = 2
+	// ExitAuth means no token is configured or the server answered
+	// 401 or 403.
+	ExitAuth = 3
+	// ExitNotFound means the server answered 404.
+	ExitNotFound = 4
+	// ExitNetwork means the server could not be reached.
+	ExitNetwork = 5
+	// ExitServer means the server answered with a 5xx status.
+	ExitServer = 6
+)
+
+// ExitCodesHelp documents the exit codes in command help. Commands that
+// run another program exit with its code instead.
+const ExitCodesHelp = `Exit codes:
+  0  success
+  1  unclassified error
+  2  validation error: invalid arguments or flags, or a 400/422 response
+  3  authentication failure: no token, or a 401/403 response
+  4  not found: a 404 response
+  5  network error: the server could not be reached
+  6  server error: a 5xx response`
+
+// ErrNotLoggedIn is returned when no API token is configured.
+var ErrNotLoggedIn = errors.New("no API token configured")
+
+// ValidationError marks an error caused by invalid user input.
+type ValidationError struct {
+	Err error
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// Validationf creates a ValidationError with a formatted message.
+func Validationf(format string, args ...interface{}) error {
+	return &ValidationError{Err: fmt.Errorf(format, args...)}
+}
+
+// ExitStatusError reports that a program run by glitchtipctl, such as the
+// job wrapped by heartbeat, failed. glitchtipctl exits with its code.
+type ExitStatusError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitStatusError) Error() string { return e.Err.Error() }
+
+func (e *ExitStatusError) Unwrap() error { return e.Err }
+
+// ExitCode maps an error returned by a command to the documented exit code.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	var statusErr *ExitStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) || isCobraUsageError(err) {
+		return ExitValidation
+	}
+	if errors.Is(err, ErrNotLoggedIn) {
+		return ExitAuth
+	}
+
+	if glitchtip.IsNotFound(err) {
+		return ExitNotFound
+	}
+
+	switch status := glitchtip.StatusCode(err); {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ExitAuth
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return ExitValidation
+	case status >= 500:
+		return ExitServer
+	case status != 0:
+		return ExitError
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return ExitNetwork
+	}
+	return ExitError
+}
+
+// isCobraUsageError recognises the usage errors cobra creates itself,
+// which are plain errors without a type to match on.
+func isCobraUsageError(err error) bool {
+	msg := err.Error()
+	for _, prefix := range []string{"unknown command", "unknown flag", "unknown shorthand flag", "required flag(s)", "invalid argument", "flag needs an argument", "accepts ", "requires at least", "requires at most", "received ", "if any flags in the group"} {
+		if strings.HasPrefix(msg, prefix) {
+			return true
+		}
+	}
+	return false
+}
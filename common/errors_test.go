@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+)
+
+func TestExitCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/0/organizations/forbidden/":
+			w.WriteHeader(http.StatusForbidden)
+		case "/api/0/organizations/invalid/":
+			w.WriteHeader(http.StatusBadRequest)
+		case "/api/0/organizations/broken/":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte(`{"detail":"nope"}`))
+	}))
+	defer server.Close()
+
+	apiErr := func(slug string) error {
+		client := glitchtip.NewClient(server.URL, "token")
+		_, err := client.GetOrganization(context.Background(), slug)
+		if err == nil {
+			t.Fatalf("expected an error for %s", slug)
+		}
+		return fmt.Errorf("wrapped: %w", err)
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"plain", errors.New("boom"), ExitError},
+		{"validation", Validationf("bad %s", "flag"), ExitValidation},
+		{"cobra usage", errors.New(`unknown command "nope" for "glitchtipctl"`), ExitValidation},
+		{"cobra args", errors.New("accepts 1 arg(s), received 0"), ExitValidation},
+		{"cobra flag group", errors.New("if any flags in the group [enable disable] are set none of the others can be; [disable enable] were all set"), ExitValidation},
+		{"not logged in", fmt.Errorf("%w: run login", ErrNotLoggedIn), ExitAuth},
+		{"not found", apiErr("missing"), ExitNotFound},
+		{"forbidden", apiErr("forbidden"), ExitAuth},
+		{"bad request", apiErr("invalid"), ExitValidation},
+		{"server", apiErr("broken"), ExitServer},
+		{"wrapped program", fmt.Errorf("job: %w", &ExitStatusError{Code: 42, Err: errors.New("exit status 42")}), 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExitCodeNetwork(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := glitchtip.NewClient(url, "token")
+	_, err := client.GetCurrentUser(context.Background())
+	if got := ExitCode(err); got != ExitNetwork {
+		t.Errorf("ExitCode(%v) = %d, want %d", err, got, ExitNetwork)
+	}
+}
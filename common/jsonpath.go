@@ -0,0 +1,278 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// This file implements the subset of kubectl's JSONPath syntax used by the
+// jsonpath and custom-columns output formats. Templates mix literal text
+// with {expression} blocks, where an expression is one of:
+//
+//	{.name}              a field, {.a.b} nested fields
+//	{[0].slug}           an array index, {[*].slug} every element
+//	{.*}                 every value of an object
+//	{range [*]}...{end}  repeat the enclosed template for each result
+//	{"\n"}               a quoted string literal
+//
+// Multiple results of one expression are separated by spaces.
+
+// jsonPathNode is one parsed piece of a template.
+type jsonPathNode struct {
+	text    string         // literal text, printed as-is
+	path    []pathStep     // expression to evaluate
+	body    []jsonPathNode // template repeated by a range block
+	isPath  bool
+	isRange bool
+}
+
+// pathStep is one field access or index in an expression.
+type pathStep struct {
+	field    string
+	index    int
+	wildcard bool
+	isIndex  bool
+}
+
+// ExecuteJSONPath renders a JSONPath template against decoded JSON data.
+func ExecuteJSONPath(template string, data interface{}) (string, error) {
+	nodes, rest, err := parseJSONPathTemplate(template, false)
+	if err != nil {
+		return "", err
+	}
+	if rest != "" {
+		return "", fmt.Errorf("jsonpath: unexpected {end}")
+	}
+
+	var out strings.Builder
+	if err := executeJSONPathNodes(&out, nodes, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// EvaluateJSONPath returns every value an expression such as ".status.name"
+// selects from data. Surrounding braces are optional.
+func EvaluateJSONPath(expression string, data interface{}) ([]interface{}, error) {
+	expression = strings.TrimSpace(expression)
+	expression = strings.TrimSuffix(strings.TrimPrefix(expression, "{"), "}")
+	steps, err := parsePath(expression)
+	if err != nil {
+		return nil, err
+	}
+	return evaluatePath(steps, data), nil
+}
+
+// parseJSONPathTemplate parses until the end of the template or, inside a
+// range block, until the matching {end}, returning the unparsed remainder.
+func parseJSONPathTemplate(template string, inRange bool) ([]jsonPathNode, string, error) {
+	var nodes []jsonPathNode
+	for template != "" {
+		open := strings.IndexByte(template, '{')
+		if open < 0 {
+			nodes = append(nodes, jsonPathNode{text: template})
+			return nodes, "", nil
+		}
+		if open > 0 {
+			nodes = append(nodes, jsonPathNode{text: template[:open]})
+		}
+
+		closeIdx := closingBrace(template, open)
+		if closeIdx < 0 {
+			return nil, "", fmt.Errorf("jsonpath: unclosed { in %q", template)
+		}
+		expression := strings.TrimSpace(template[open+1 : closeIdx])
+		template = template[closeIdx+1:]
+
+		switch {
+		case expression == "end":
+			if !inRange {
+				return nil, "", fmt.Errorf("jsonpath: {end} without {range}")
+			}
+			return nodes, template, nil
+		case strings.HasPrefix(expression, "range "):
+			steps, err := parsePath(strings.TrimSpace(strings.TrimPrefix(expression, "range ")))
+			if err != nil {
+				return nil, "", err
+			}
+			// The nested parse fails unless it finds the matching {end}.
+			body, rest, err := parseJSONPathTemplate(template, true)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonPathNode{path: steps, body: body, isPath: true, isRange: true})
+			template = rest
+		case strings.HasPrefix(expression, `"`):
+			literal, err := strconv.Unquote(expression)
+			if err != nil {
+				return nil, "", fmt.Errorf("jsonpath: invalid string literal %s", expression)
+			}
+			nodes = append(nodes, jsonPathNode{text: literal})
+		default:
+			steps, err := parsePath(expression)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonPathNode{path: steps, isPath: true})
+		}
+	}
+	if inRange {
+		return nil, "", fmt.Errorf("jsonpath: {range} without {end}")
+	}
+	return nodes, "", nil
+}
+
+// closingBrace finds the } matching the { at open, skipping quoted strings.
+func closingBrace(template string, open int) int {
+	inQuote := false
+	for i := open + 1; i < len(template); i++ {
+		switch template[i] {
+		case '\\':
+			if inQuote {
+				i++
+			}
+		case '"':
+			inQuote = !inQuote
+		case '}':
+			if !inQuote {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parsePath splits an expression like ".items[*].name" into steps. A
+// leading "$" or "@" for the current object is accepted and ignored.
+func parsePath(expression string) ([]pathStep, error) {
+	expression = strings.TrimPrefix(strings.TrimPrefix(expression, "$"), "@")
+	var steps []pathStep
+	for expression != "" {
+		switch expression[0] {
+		case '.':
+			expression = expression[1:]
+			end := strings.IndexAny(expression, ".[")
+			if end < 0 {
+				end = len(expression)
+			}
+			field := expression[:end]
+			expression = expression[end:]
+			switch field {
+			case "":
+				// A bare "." selects the current object.
+			case "*":
+				steps = append(steps, pathStep{wildcard: true})
+			default:
+				steps = append(steps, pathStep{field: field})
+			}
+		case '[':
+			end := strings.IndexByte(expression, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("jsonpath: unclosed [ in %q", expression)
+			}
+			inner := strings.TrimSpace(expression[1:end])
+			expression = expression[end+1:]
+			switch {
+			case inner == "*":
+				steps = append(steps, pathStep{wildcard: true})
+			case strings.HasPrefix(inner, "'") || strings.HasPrefix(inner, `"`):
+				steps = append(steps, pathStep{field: strings.Trim(inner, `'"`)})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("jsonpath: invalid index [%s]", inner)
+				}
+				steps = append(steps, pathStep{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("jsonpath: unexpected %q, paths must start with . or [", expression)
+		}
+	}
+	return steps, nil
+}
+
+// evaluatePath applies steps to data, fanning out on wildcards. Missing
+// fields simply produce no results.
+func evaluatePath(steps []pathStep, data interface{}) []interface{} {
+	current := []interface{}{data}
+	for _, step := range steps {
+		var next []interface{}
+		for _, value := range current {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					keys := make([]string, 0, len(v))
+					for key := range v {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, v[key])
+					}
+				} else if field, ok := v[step.field]; ok && !step.isIndex {
+					next = append(next, field)
+				}
+			case []interface{}:
+				if step.wildcard {
+					next = append(next, v...)
+				} else if step.isIndex {
+					index := step.index
+					if index < 0 {
+						index += len(v)
+					}
+					if index >= 0 && index < len(v) {
+						next = append(next, v[index])
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+func executeJSONPathNodes(out *strings.Builder, nodes []jsonPathNode, data interface{}) error {
+	for _, node := range nodes {
+		switch {
+		case node.isRange:
+			for _, item := range evaluatePath(node.path, data) {
+				if err := executeJSONPathNodes(out, node.body, item); err != nil {
+					return err
+				}
+			}
+		case node.isPath:
+			values := evaluatePath(node.path, data)
+			for i, value := range values {
+				if i > 0 {
+					out.WriteByte(' ')
+				}
+				out.WriteString(formatJSONValue(value))
+			}
+		default:
+			out.WriteString(node.text)
+		}
+	}
+	return nil
+}
+
+// formatJSONValue prints scalars plainly and anything else as JSON.
+func formatJSONValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, err := marshalJSON(v, false)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return strings.TrimSpace(string(encoded))
+	}
+}
@@ -0,0 +1,93 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, data string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("invalid test JSON: %v", err)
+	}
+	return v
+}
+
+func TestExecuteJSONPath(t *testing.T) {
+	data := decodeJSON(t, `[
+		{"slug": "web", "id": 1, "teams": [{"slug": "a"}, {"slug": "b"}], "status": {"name": "active"}, "public": true},
+		{"slug": "api", "id": 2, "teams": [], "status": {"name": "disabled"}, "public": false}
+	]`)
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"field of index", `{[0].slug}`, "web"},
+		{"nested field", `{[1].status.name}`, "disabled"},
+		{"wildcard", `{[*].slug}`, "web api"},
+		{"dotted wildcard", `{.[*].id}`, "1 2"},
+		{"negative index", `{[-1].slug}`, "api"},
+		{"out of range index", `{[5].slug}`, ""},
+		{"object wildcard", `{[0].status.*}`, "active"},
+		{"bracket field", `{[0]['slug']}`, "web"},
+		{"booleans", `{[*].public}`, "true false"},
+		{"nested wildcard", `{[0].teams[*].slug}`, "a b"},
+		{"literal text", `slug={[0].slug}!`, "slug=web!"},
+		{"quoted literal", `{[0].slug}{"\t"}{[1].slug}{"\n"}`, "web\tapi\n"},
+		{"quoted brace", `{"}"}`, "}"},
+		{"range", `{range [*]}{.slug}:{.id}{"\n"}{end}`, "web:1\napi:2\n"},
+		{"nested range", `{range [*]}{.slug}[{range .teams[*]}{.slug}{end}]{end}`, "web[ab]api[]"},
+		{"root", `{$[0].slug}`, "web"},
+		{"object value", `{[0].status}`, `{"name":"active"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExecuteJSONPath(tt.template, data)
+			if err != nil {
+				t.Fatalf("ExecuteJSONPath(%q): %v", tt.template, err)
+			}
+			if got != tt.want {
+				t.Errorf("ExecuteJSONPath(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteJSONPathErrors(t *testing.T) {
+	for _, template := range []string{
+		`{[0].slug`,
+		`{range [*]}{.slug}`,
+		`{.slug}{end}`,
+		`{[abc]}`,
+		`{[0}`,
+		`{slug}`,
+		`{"unterminated\"}`,
+		`{range [x]}{end}`,
+	} {
+		if _, err := ExecuteJSONPath(template, []interface{}{}); err == nil {
+			t.Errorf("ExecuteJSONPath(%q) succeeded, want an error", template)
+		}
+	}
+}
+
+func TestEvaluateJSONPath(t *testing.T) {
+	data := decodeJSON(t, `{"teams": [{"slug": "a"}, {"slug": "b"}], "name": "web"}`)
+
+	for expression, want := range map[string]int{
+		".name":          1,
+		"{.name}":        1,
+		".teams[*].slug": 2,
+		".missing":       0,
+	} {
+		results, err := EvaluateJSONPath(expression, data)
+		if err != nil {
+			t.Fatalf("EvaluateJSONPath(%q): %v", expression, err)
+		}
+		if len(results) != want {
+			t.Errorf("EvaluateJSONPath(%q) returned %d results, want %d", expression, len(results), want)
+		}
+	}
+}
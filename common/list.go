@@ -0,0 +1,30 @@
+package common
+
+import (
+	"github.com/nanyte25/glitchtipctl/pkg/glitchtip"
+	"github.com/spf13/cobra"
+)
+
+// AddListFlags registers the --limit and --page-size flags on a command
+// that lists a paginated resource.
+func AddListFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("limit", 0, "Maximum number of items to return (0 returns every item)")
+	cmd.Flags().Int("page-size", 0, "Number of items to request per page (0 uses the server default)")
+}
+
+// ListOptions returns the pagination options set by a command's --limit
+// and --page-size flags.
+func ListOptions(cmd *cobra.Command) (*glitchtip.ListOptions, error) {
+	limit, err := cmd.Flags().GetInt("limit")
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := cmd.Flags().GetInt("page-size")
+	if err != nil {
+		return nil, err
+	}
+	if limit < 0 || pageSize < 0 {
+		return nil, Validationf("--limit and --page-size must not be negative")
+	}
+	return &glitchtip.ListOptions{Limit: limit, PageSize: pageSize}, nil
+}
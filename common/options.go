@@ -0,0 +1,16 @@
+package common
+
+// GlobalOptions holds the values of the persistent flags defined on the
+// root command and shared by every subcommand.
+type GlobalOptions struct {
+	// Context selects a named context instead of the current one.
+	Context string
+	// NoSpinner disables the progress spinner even on a terminal.
+	NoSpinner bool
+	// Quiet disables the spinner and all status messages, leaving only
+	// the requested output.
+	Quiet bool
+}
+
+// Options is populated by the root command's persistent flags.
+var Options GlobalOptions
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// Infof prints a status message such as "Project created" to stderr, so
+// stdout only carries the requested output. --quiet suppresses it.
+func Infof(format string, args ...interface{}) {
+	if Options.Quiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+// SpinnerEnabled reports whether progress spinners may be drawn: stderr
+// must be a terminal and neither --no-spinner nor --quiet may be set.
+func SpinnerEnabled() bool {
+	if Options.NoSpinner || Options.Quiet {
+		return false
+	}
+	return term.IsTerminal(os.Stderr.Fd())
+}
@@ -0,0 +1,253 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormats lists the values accepted by the -o/--output flag.
+const OutputFormats = "table|wide|json|yaml|name|custom-columns=HEADER:.path,...|go-template=...|go-template-file=...|jsonpath=...|jsonpath-file=..."
+
+// Column is one column of table output.
+type Column[T any] struct {
+	Header string
+	// Wide columns are only shown with -o wide.
+	Wide  bool
+	Value func(item T) string
+}
+
+// ResourcePrinter renders one kind of resource in every supported output
+// format, so that all get commands behave the same way.
+type ResourcePrinter[T any] struct {
+	// Kind is the singular resource name used by -o name, e.g. "project".
+	Kind string
+	// Name returns the identifier printed by -o name, usually the slug.
+	Name    func(item T) string
+	Columns []Column[T]
+}
+
+// AddOutputFlag registers the -o/--output flag on a command that prints
+// resources.
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", "table", "Output format: "+OutputFormats)
+}
+
+// OutputFormat returns the validated value of a command's --output flag.
+func OutputFormat(cmd *cobra.Command) (string, error) {
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+	if _, _, err := parseOutputFormat(format); err != nil {
+		return "", err
+	}
+	return format, nil
+}
+
+// parseOutputFormat splits "kind=argument" formats and checks the kind.
+func parseOutputFormat(format string) (string, string, error) {
+	kind, argument, _ := strings.Cut(format, "=")
+	switch kind {
+	case "", "table", "wide", "json", "yaml", "name":
+		return kind, argument, nil
+	case "custom-columns", "go-template", "jsonpath":
+		if argument == "" {
+			return "", "", Validationf("output format %s requires a value, e.g. -o %s=...", kind, kind)
+		}
+		return kind, argument, nil
+	case "go-template-file", "jsonpath-file":
+		if argument == "" {
+			return "", "", Validationf("output format %s requires a file name", kind)
+		}
+		data, err := os.ReadFile(argument)
+		if err != nil {
+			return "", "", fmt.Errorf("error reading template file: %w", err)
+		}
+		return strings.TrimSuffix(kind, "-file"), string(data), nil
+	default:
+		return "", "", Validationf("unknown output format %q, expected one of %s", format, OutputFormats)
+	}
+}
+
+// PrintList writes a list of resources in the given output format.
+func (p ResourcePrinter[T]) PrintList(w io.Writer, format string, items []T) error {
+	return p.print(w, format, items, items)
+}
+
+// PrintObject writes a single resource in the given output format.
+func (p ResourcePrinter[T]) PrintObject(w io.Writer, format string, item T) error {
+	return p.print(w, format, []T{item}, item)
+}
+
+// print renders rows for the tabular formats and data for the structured
+// ones, which keep the single object or list shape of the API.
+func (p ResourcePrinter[T]) print(w io.Writer, format string, rows []T, data interface{}) error {
+	kind, argument, err := parseOutputFormat(format)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case "json":
+		encoded, err := marshalJSON(data, true)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(encoded)
+		return err
+	case "yaml":
+		generic, err := toGeneric(data)
+		if err != nil {
+			return err
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(generic); err != nil {
+			return fmt.Errorf("error encoding YAML: %w", err)
+		}
+		return encoder.Close()
+	case "name":
+		for _, row := range rows {
+			fmt.Fprintf(w, "%s/%s\n", p.Kind, p.Name(row))
+		}
+		return nil
+	case "custom-columns":
+		return printCustomColumns(w, argument, rows)
+	case "go-template":
+		generic, err := toGeneric(data)
+		if err != nil {
+			return err
+		}
+		tmpl, err := template.New("output").Parse(argument)
+		if err != nil {
+			return fmt.Errorf("error parsing template: %w", err)
+		}
+		return tmpl.Execute(w, generic)
+	case "jsonpath":
+		generic, err := toGeneric(data)
+		if err != nil {
+			return err
+		}
+		result, err := ExecuteJSONPath(argument, generic)
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(result, "\n") {
+			result += "\n"
+		}
+		_, err = io.WriteString(w, result)
+		return err
+	default:
+		p.printTable(w, rows, kind == "wide")
+		return nil
+	}
+}
+
+func (p ResourcePrinter[T]) printTable(w io.Writer, rows []T, wide bool) {
+	var columns []Column[T]
+	for _, column := range p.Columns {
+		if wide || !column.Wide {
+			columns = append(columns, column)
+		}
+	}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.Header
+	}
+
+	table := NewTable(w, headers)
+	for _, row := range rows {
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			values[i] = column.Value(row)
+		}
+		table.Append(values)
+	}
+	table.Render()
+}
+
+// printCustomColumns renders a table from a spec such as
+// "NAME:.name,TEAMS:.teams[*].slug".
+func printCustomColumns[T any](w io.Writer, spec string, rows []T) error {
+	var headers, paths []string
+	for _, column := range strings.Split(spec, ",") {
+		header, path, ok := strings.Cut(column, ":")
+		if !ok {
+			return Validationf("custom-columns must be HEADER:.path pairs, got %q", column)
+		}
+		headers = append(headers, header)
+		paths = append(paths, path)
+	}
+
+	table := NewTable(w, headers)
+	for _, row := range rows {
+		generic, err := toGeneric(row)
+		if err != nil {
+			return err
+		}
+		values := make([]string, len(paths))
+		for i, path := range paths {
+			results, err := EvaluateJSONPath(path, generic)
+			if err != nil {
+				return err
+			}
+			formatted := make([]string, len(results))
+			for j, result := range results {
+				formatted[j] = formatJSONValue(result)
+			}
+			values[i] = strings.Join(formatted, ",")
+			if values[i] == "" {
+				values[i] = "<none>"
+			}
+		}
+		table.Append(values)
+	}
+	table.Render()
+	return nil
+}
+
+// NewTable creates a table writer with the style used across glitchtipctl.
+func NewTable(w io.Writer, headers []string) *tablewriter.Table {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(headers)
+	return table
+}
+
+// toGeneric converts a typed value into maps and slices keyed by its JSON
+// field names, which is what templates and YAML output operate on.
+func toGeneric(v interface{}) (interface{}, error) {
+	encoded, err := marshalJSON(v, false)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(encoded, &generic); err != nil {
+		return nil, fmt.Errorf("error decoding JSON: %w", err)
+	}
+	return generic, nil
+}
+
+// marshalJSON encodes v without escaping HTML characters, optionally
+// indented for humans.
+func marshalJSON(v interface{}, indent bool) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if indent {
+		encoder.SetIndent("", "  ")
+	}
+	if err := encoder.Encode(v); err != nil {
+		return nil, fmt.Errorf("error encoding JSON: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// stdinReader is shared by every prompt so buffered input is not lost
+// between them.
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// IsInteractive reports whether stdin is a terminal a user can answer
+// prompts on.
+func IsInteractive() bool {
+	return term.IsTerminal(os.Stdin.Fd())
+}
+
+// Prompt asks for a line of input. An empty answer returns defaultValue.
+func Prompt(label, defaultValue string) (string, error) {
+	// Prompts go to stderr so they never end up in redirected output.
+	if defaultValue != "" {
+		fmt.Fprintf(os.Stderr, "%s [%s]: ", label, defaultValue)
+	} else {
+		fmt.Fprintf(os.Stderr, "%s: ", label)
+	}
+
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("error reading input: %w", err)
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return defaultValue, nil
+	}
+	return line, nil
+}
+
+// PromptSecret asks for input without echoing it to the terminal.
+func PromptSecret(label string) (string, error) {
+	fmt.Fprintf(os.Stderr, "%s: ", label)
+	secret, err := term.ReadPassword(os.Stdin.Fd())
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("error reading input: %w", err)
+	}
+	return strings.TrimSpace(string(secret)), nil
+}
+
+// Confirm asks a yes/no question. Only "y" or "yes" count as yes.
+func Confirm(question string) (bool, error) {
+	answer, err := Prompt(question+" [y/N]", "")
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
@@ -1,39 +1,41 @@
 package common
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+	"errors"
+	"os"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/olekukonko/tablewriter"
 )
 
+// FetchResultMsg carries the outcome of the command a SpinnerModel waits
+// for.
+type FetchResultMsg struct {
+	Value interface{}
+	Err   error
+}
+
 // SpinnerModel represents the spinner model struct
 type SpinnerModel struct {
 	Spinner  spinner.Model
 	Quitting bool
-	ApiToken string
-	OrgSlug  string
-	Result   string
+	Message  string
+	Fetch    tea.Cmd
+	Result   interface{}
 	Err      error
 }
 
-// NewSpinnerModel creates a new SpinnerModel instance
-func NewSpinnerModel(apiToken string, orgSlug string) SpinnerModel {
+// NewSpinnerModel creates a new SpinnerModel instance. The fetch command
+// runs while the spinner is displayed and must return a FetchResultMsg.
+func NewSpinnerModel(message string, fetch tea.Cmd) SpinnerModel {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	return SpinnerModel{Spinner: s, ApiToken: apiToken, OrgSlug: orgSlug}
+	return SpinnerModel{Spinner: s, Message: message, Fetch: fetch}
 }
 
-// Init initializes the spinner and fetches data concurrently
+// Init starts the spinner and the fetch command concurrently
 func (m SpinnerModel) Init() tea.Cmd {
-	// The fetchData function should be implemented in the specific context
-	// that uses this spinner model, so this is just a placeholder.
-	return tea.Batch(m.Spinner.Tick)
+	return tea.Batch(m.Spinner.Tick, m.Fetch)
 }
 
 // Update handles spinner ticks and results
@@ -43,85 +45,56 @@ func (m SpinnerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		m.Spinner, cmd = m.Spinner.Update(msg)
 		return m, cmd
-	case string:
-		m.Quitting = true
-		m.Result = msg
-		return m, tea.Quit
-	case error:
+	case FetchResultMsg:
 		m.Quitting = true
-		m.Err = msg
+		m.Result = msg.Value
+		m.Err = msg.Err
 		return m, tea.Quit
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			m.Quitting = true
+			m.Err = errInterrupted
+			return m, tea.Quit
+		}
 	}
 	return m, nil
 }
 
-// View displays the spinner or the result
+// View displays the spinner. The result is printed by the caller once the
+// program has exited, so that it is neither clipped to the terminal size
+// nor mixed with escape codes.
 func (m SpinnerModel) View() string {
 	if m.Quitting {
-		if m.Err != nil {
-			return "Error: " + m.Err.Error() + "\n"
-		}
-		return m.Result + "\n"
+		return ""
 	}
-	return m.Spinner.View() + " Fetching data..."
+	return m.Spinner.View() + " " + m.Message + "\n"
 }
 
-// Example fetch function - can be replaced based on usage context
-func fetchMembers(apiToken, orgSlug string) func() tea.Msg {
-	return func() tea.Msg {
-		url := fmt.Sprintf("http://localhost:8000/api/0/organizations/%s/members/", orgSlug)
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return fmt.Sprintf("Error creating request: %v", err)
-		}
-
-		req.Header.Add("Authorization", "Bearer "+apiToken)
+// errInterrupted is returned when the user presses Ctrl+C while waiting.
+var errInterrupted = errors.New("interrupted")
 
-		resp, err := http.DefaultClient.Do(req)
-		if err != nil {
-			return fmt.Sprintf("Error fetching members: %v", err)
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			return fmt.Sprintf("Error: received status code %d", resp.StatusCode)
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Sprintf("Error reading response body: %v", err)
-		}
-
-		var members []map[string]interface{}
-		err = json.Unmarshal(body, &members)
-		if err != nil {
-			return fmt.Sprintf("Error parsing JSON response: %v", err)
-		}
-
-		// Convert the members to a formatted table
-		return formatMembersTable(members)
+// FetchWithSpinner runs fetch while showing a spinner on stderr and returns
+// its result. When the spinner is disabled, for example because stderr is
+// not a terminal, fetch runs directly without starting Bubble Tea.
+func FetchWithSpinner[T any](message string, fetch func() (T, error)) (T, error) {
+	if !SpinnerEnabled() {
+		return fetch()
 	}
-}
-
-// formatMembersTable converts the members data into a formatted table string
-func formatMembersTable(members []map[string]interface{}) string {
-	headers := []string{"ID", "Name", "Email"}
 
-	// Create a buffer to capture the table output
-	var buffer bytes.Buffer
-	table := tablewriter.NewWriter(&buffer)
-	table.SetHeader(headers)
+	model := NewSpinnerModel(message, func() tea.Msg {
+		value, err := fetch()
+		return FetchResultMsg{Value: value, Err: err}
+	})
+	program := tea.NewProgram(model, tea.WithOutput(os.Stderr))
 
-	for _, member := range members {
-		id := fmt.Sprintf("%v", member["id"])
-		name := fmt.Sprintf("%v", member["name"])
-		email := fmt.Sprintf("%v", member["email"])
-		table.Append([]string{id, name, email})
+	var zero T
+	final, err := program.Run()
+	if err != nil {
+		return zero, err
 	}
-
-	// Render the table into the buffer
-	table.Render()
-
-	// Return the buffer's contents as a string
-	return buffer.String()
+	result := final.(SpinnerModel)
+	if result.Err != nil {
+		return zero, result.Err
+	}
+	return result.Result.(T), nil
 }
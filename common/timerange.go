@@ -0,0 +1,44 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTime parses the value of a time flag such as --since. It accepts an
+// RFC 3339 timestamp, a date (2006-01-02), a Unix timestamp in seconds or a
+// duration before now, where d and w are accepted as days and weeks in
+// addition to Go durations: "90m", "24h", "14d", "2w".
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds > 0 {
+		return time.Unix(seconds, 0), nil
+	}
+
+	unit := value[len(value)-1]
+	if unit == 'd' || unit == 'w' {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err == nil && n >= 0 {
+			days := n
+			if unit == 'w' {
+				days *= 7
+			}
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, Validationf("invalid time %q, expected a duration such as 24h or 14d, a date, an RFC 3339 timestamp or a Unix timestamp", value)
+}
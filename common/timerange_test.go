@@ -0,0 +1,40 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"", time.Time{}},
+		{"90m", now.Add(-90 * time.Minute)},
+		{"24h", now.Add(-24 * time.Hour)},
+		{"14d", now.AddDate(0, 0, -14)},
+		{"2w", now.AddDate(0, 0, -14)},
+		{"2024-05-01T08:00:00Z", time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)},
+		{"1714550400", time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.value, now)
+		if err != nil {
+			t.Errorf("ParseTime(%q): %v", tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"yesterday", "-3d", "5x"} {
+		if _, err := ParseTime(value, now); err == nil {
+			t.Errorf("ParseTime(%q) should fail", value)
+		} else if ExitCode(err) != ExitValidation {
+			t.Errorf("ParseTime(%q) error should be a validation error", value)
+		}
+	}
+}
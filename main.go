@@ -1,18 +1,14 @@
 package main
 
 import (
-	"log"
-
 	"github.com/joho/godotenv"
 	"github.com/nanyte25/glitchtipctl/cmd"
 )
 
 func main() {
-	// Load the .env file
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatalf("Error loading .env file: %v", err)
-	}
+	// Load a .env file if there is one. Credentials normally come from the
+	// config contexts, so a missing file is not an error.
+	_ = godotenv.Load()
 
 	// Run the CLI tool
 	cmd.Execute()
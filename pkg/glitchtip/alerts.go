@@ -0,0 +1,121 @@
+package glitchtip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Alert recipient types.
+const (
+	RecipientEmail      = "email"
+	RecipientWebhook    = "webhook"
+	RecipientDiscord    = "discord"
+	RecipientGoogleChat = "googlechat"
+)
+
+// ProjectAlert is an alert rule of a project. It fires when Quantity
+// events arrive within TimespanMinutes, or when an uptime monitor of the
+// project goes down if Uptime is set.
+type ProjectAlert struct {
+	ID              ID               `json:"id"`
+	Name            string           `json:"name"`
+	TimespanMinutes int              `json:"timespanMinutes"`
+	Quantity        int              `json:"quantity"`
+	Uptime          bool             `json:"uptime"`
+	AlertRecipients []AlertRecipient `json:"alertRecipients"`
+}
+
+// AlertRecipient is where an alert is sent. Email recipients notify the
+// project's team members, the others post to URL.
+type AlertRecipient struct {
+	ID            ID     `json:"id,omitempty"`
+	RecipientType string `json:"recipientType"`
+	URL           string `json:"url"`
+}
+
+// ProjectAlertRequest is the payload for creating or updating an alert
+// rule. Updates replace the rule, so start from ProjectAlert.Request to
+// keep the fields that do not change.
+type ProjectAlertRequest struct {
+	Name            string           `json:"name"`
+	TimespanMinutes int              `json:"timespanMinutes,omitempty"`
+	Quantity        int              `json:"quantity,omitempty"`
+	Uptime          bool             `json:"uptime"`
+	AlertRecipients []AlertRecipient `json:"alertRecipients"`
+}
+
+// Request returns the payload that recreates the alert rule as it is.
+func (a ProjectAlert) Request() ProjectAlertRequest {
+	recipients := make([]AlertRecipient, len(a.AlertRecipients))
+	for i, recipient := range a.AlertRecipients {
+		recipients[i] = AlertRecipient{RecipientType: recipient.RecipientType, URL: recipient.URL}
+	}
+	return ProjectAlertRequest{
+		Name:            a.Name,
+		TimespanMinutes: a.TimespanMinutes,
+		Quantity:        a.Quantity,
+		Uptime:          a.Uptime,
+		AlertRecipients: recipients,
+	}
+}
+
+// ListProjectAlerts returns the alert rules of a project.
+func (c *Client) ListProjectAlerts(ctx context.Context, orgSlug, projectSlug string) ([]ProjectAlert, error) {
+	return newIterator[ProjectAlert](ctx, c, projectAlertsPath(orgSlug, projectSlug), nil, nil).All()
+}
+
+// FindProjectAlert looks an alert rule up by ID or by name. The error
+// wraps ErrNotFound when no rule matches.
+func (c *Client) FindProjectAlert(ctx context.Context, orgSlug, projectSlug, idOrName string) (*ProjectAlert, error) {
+	alerts, err := c.ListProjectAlerts(ctx, orgSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range alerts {
+		if alert.ID.String() == idOrName {
+			return &alert, nil
+		}
+	}
+	var found *ProjectAlert
+	for i, alert := range alerts {
+		if alert.Name != "" && strings.EqualFold(alert.Name, idOrName) {
+			if found != nil {
+				return nil, fmt.Errorf("project %q has several alerts named %q, use the alert ID instead", projectSlug, idOrName)
+			}
+			found = &alerts[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no alert %q in project %q: %w", idOrName, projectSlug, ErrNotFound)
+	}
+	return found, nil
+}
+
+// CreateProjectAlert creates an alert rule for a project.
+func (c *Client) CreateProjectAlert(ctx context.Context, orgSlug, projectSlug string, payload ProjectAlertRequest) (*ProjectAlert, error) {
+	var alert ProjectAlert
+	if err := c.post(ctx, projectAlertsPath(orgSlug, projectSlug), payload, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// UpdateProjectAlert replaces an alert rule of a project.
+func (c *Client) UpdateProjectAlert(ctx context.Context, orgSlug, projectSlug, alertID string, payload ProjectAlertRequest) (*ProjectAlert, error) {
+	var alert ProjectAlert
+	if err := c.put(ctx, projectAlertsPath(orgSlug, projectSlug)+pathEscape(alertID)+"/", payload, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// DeleteProjectAlert deletes an alert rule of a project.
+func (c *Client) DeleteProjectAlert(ctx context.Context, orgSlug, projectSlug, alertID string) error {
+	return c.delete(ctx, projectAlertsPath(orgSlug, projectSlug)+pathEscape(alertID)+"/")
+}
+
+// projectAlertsPath is the alert list endpoint of a project.
+func projectAlertsPath(orgSlug, projectSlug string) string {
+	return projectPath(orgSlug, projectSlug) + "alerts/"
+}
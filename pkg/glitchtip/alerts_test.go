@@ -0,0 +1,50 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateProjectAlert(t *testing.T) {
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[{"id":4,"name":"Errors","timespanMinutes":5,"quantity":10,"uptime":false,"alertRecipients":[{"id":9,"recipientType":"email","url":""}]},{"id":5,"name":"","timespanMinutes":null,"quantity":null,"uptime":true,"alertRecipients":[]}]`))
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	alert, err := client.FindProjectAlert(ctx, "acme", "web", "errors")
+	if err != nil || alert.ID != "4" {
+		t.Fatalf("FindProjectAlert by name = %+v, %v", alert, err)
+	}
+	if alert, err := client.FindProjectAlert(ctx, "acme", "web", "5"); err != nil || !alert.Uptime {
+		t.Errorf("FindProjectAlert by ID = %+v, %v", alert, err)
+	}
+	if _, err := client.FindProjectAlert(ctx, "acme", "web", "crashes"); !IsNotFound(err) {
+		t.Errorf("FindProjectAlert of an unknown alert = %v, want a not found error", err)
+	}
+
+	payload := alert.Request()
+	payload.AlertRecipients = append(payload.AlertRecipients, AlertRecipient{RecipientType: RecipientDiscord, URL: "https://discord.com/api/webhooks/1"})
+	if _, err := client.UpdateProjectAlert(ctx, "acme", "web", alert.ID.String(), payload); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/api/0/projects/acme/web/alerts/4/" {
+		t.Errorf("request = %s %s", method, path)
+	}
+	want := `{"name":"Errors","timespanMinutes":5,"quantity":10,"uptime":false,"alertRecipients":[{"recipientType":"email","url":""},{"recipientType":"discord","url":"https://discord.com/api/webhooks/1"}]}`
+	if body != want {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+}
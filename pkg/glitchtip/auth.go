@@ -0,0 +1,109 @@
+package glitchtip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+)
+
+// DefaultTokenScopes are the scopes requested for tokens created by Login.
+var DefaultTokenScopes = []string{
+	"org:read", "org:write", "org:admin",
+	"team:read", "team:write", "team:admin",
+	"project:read", "project:write", "project:admin", "project:releases",
+	"member:read", "member:write", "member:admin",
+	"event:read", "event:write", "event:admin",
+}
+
+// APIToken is a personal API token.
+type APIToken struct {
+	ID     ID       `json:"id"`
+	Label  string   `json:"label"`
+	Token  string   `json:"token"`
+	Scopes []string `json:"scopes"`
+}
+
+// Login signs in with an email and password and creates a new API token
+// labelled tokenLabel. The session used to create the token is discarded,
+// only the token is returned.
+func Login(ctx context.Context, baseURL, email, password, tokenLabel string) (*APIToken, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	session := &sessionClient{
+		baseURL:    normalizeBaseURL(baseURL),
+		httpClient: &http.Client{Jar: jar},
+	}
+
+	// Fetching the session state hands out the CSRF cookie that the
+	// following requests must echo back. It answers 401 while logged out,
+	// so only transport errors matter here and the login call reports them.
+	_ = session.send(ctx, http.MethodGet, "/_allauth/browser/v1/auth/session", nil, nil)
+
+	credentials := map[string]string{"email": email, "password": password}
+	if err := session.send(ctx, http.MethodPost, "/_allauth/browser/v1/auth/login", credentials, nil); err != nil {
+		return nil, fmt.Errorf("login failed: %w", err)
+	}
+
+	payload := map[string]interface{}{"label": tokenLabel, "scopes": DefaultTokenScopes}
+	var token APIToken
+	if err := session.send(ctx, http.MethodPost, apiPrefix+"api-tokens/", payload, &token); err != nil {
+		return nil, fmt.Errorf("error creating API token: %w", err)
+	}
+	return &token, nil
+}
+
+// sessionClient issues cookie-authenticated requests the way the GlitchTip
+// web frontend does.
+type sessionClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func (s *sessionClient) send(ctx context.Context, method, path string, body, v interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling payload: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Referer", s.baseURL+"/")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if csrf := s.cookie("csrftoken"); csrf != "" {
+		req.Header.Set("X-CSRFToken", csrf)
+	}
+
+	c := &Client{baseURL: s.baseURL, httpClient: s.httpClient}
+	_, err = c.do(req, v)
+	return err
+}
+
+// cookie returns the value of a cookie set by the server.
+func (s *sessionClient) cookie(name string) string {
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return ""
+	}
+	for _, cookie := range s.httpClient.Jar.Cookies(u) {
+		if cookie.Name == name {
+			return cookie.Value
+		}
+	}
+	return ""
+}
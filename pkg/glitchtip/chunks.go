@@ -0,0 +1,309 @@
+package glitchtip
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// uploadAttempts is how often a failed upload request is sent.
+const uploadAttempts = 3
+
+// assemblePollInterval is the wait between checks of an assembling
+// bundle.
+var assemblePollInterval = time.Second
+
+// assembleTimeout is how long to wait for a bundle to be assembled.
+var assembleTimeout = 5 * time.Minute
+
+// Assemble states reported by the server.
+const (
+	AssembleStateCreated    = "created"
+	AssembleStateAssembling = "assembling"
+	AssembleStateOK         = "ok"
+	AssembleStateError      = "error"
+	AssembleStateNotFound   = "not_found"
+)
+
+// ChunkUploadOptions describe how the server accepts chunked uploads.
+type ChunkUploadOptions struct {
+	URL              string   `json:"url"`
+	ChunkSize        int      `json:"chunkSize"`
+	ChunksPerRequest int      `json:"chunksPerRequest"`
+	MaxFileSize      int64    `json:"maxFileSize"`
+	MaxRequestSize   int      `json:"maxRequestSize"`
+	Concurrency      int      `json:"concurrency"`
+	HashAlgorithm    string   `json:"hashAlgorithm"`
+	Compression      []string `json:"compression"`
+	Accept           []string `json:"accept"`
+}
+
+// Accepts reports whether the server takes chunked uploads of a kind such
+// as "release_files".
+func (o *ChunkUploadOptions) Accepts(kind string) bool {
+	for _, accepted := range o.Accept {
+		if accepted == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// AssembleResponse is the state of a bundle assembled from chunks.
+type AssembleResponse struct {
+	State         string   `json:"state"`
+	MissingChunks []string `json:"missingChunks"`
+	Detail        string   `json:"detail"`
+}
+
+// BundleUploadOptions tune UploadReleaseBundle.
+type BundleUploadOptions struct {
+	// Concurrency caps the parallel chunk requests. Zero uses the server's
+	// recommendation.
+	Concurrency int
+	// Progress, when set, is called after every uploaded request with the
+	// number of chunks uploaded so far and in total.
+	Progress func(done, total int)
+}
+
+// GetChunkUploadOptions returns the chunked upload settings of the server.
+// Servers without chunked uploads answer with a 404 error.
+func (c *Client) GetChunkUploadOptions(ctx context.Context, orgSlug string) (*ChunkUploadOptions, error) {
+	var options ChunkUploadOptions
+	if err := c.get(ctx, organizationPath(orgSlug)+"chunk-upload/", nil, &options); err != nil {
+		return nil, err
+	}
+	return &options, nil
+}
+
+// UploadReleaseBundle uploads a release bundle, a zip archive of files with
+// a manifest.json, in chunks and waits until the server has assembled it
+// into release files. Chunks the server already has are skipped, and
+// failed chunk requests are retried.
+func (c *Client) UploadReleaseBundle(ctx context.Context, orgSlug, version string, options *ChunkUploadOptions, bundle []byte, opts BundleUploadOptions) error {
+	if options.MaxFileSize > 0 && int64(len(bundle)) > options.MaxFileSize {
+		return fmt.Errorf("the bundle is %d bytes, more than the %d bytes the server accepts", len(bundle), options.MaxFileSize)
+	}
+
+	chunks := splitChunks(bundle, options.ChunkSize)
+	checksums := make([]string, len(chunks))
+	byChecksum := make(map[string][]byte, len(chunks))
+	for i, chunk := range chunks {
+		checksums[i] = sha1Hex(chunk)
+		byChecksum[checksums[i]] = chunk
+	}
+	checksum := sha1Hex(bundle)
+
+	// Ask for the bundle first, so that only chunks the server is missing
+	// are uploaded.
+	response, err := c.assembleReleaseBundle(ctx, orgSlug, version, checksum, checksums)
+	if err != nil {
+		return err
+	}
+	if len(response.MissingChunks) > 0 {
+		missing := make([][]byte, 0, len(response.MissingChunks))
+		for _, sum := range response.MissingChunks {
+			if chunk, ok := byChecksum[sum]; ok {
+				missing = append(missing, chunk)
+			}
+		}
+		if err := c.uploadChunks(ctx, options, missing, opts); err != nil {
+			return err
+		}
+		if response, err = c.assembleReleaseBundle(ctx, orgSlug, version, checksum, checksums); err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(assembleTimeout)
+	for {
+		switch response.State {
+		case AssembleStateOK:
+			return nil
+		case AssembleStateError:
+			return fmt.Errorf("the server could not process the release bundle: %s", response.Detail)
+		case AssembleStateNotFound:
+			return fmt.Errorf("the server is still missing %d chunks of the release bundle", len(response.MissingChunks))
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the server did not finish assembling the release bundle within %s, it is still %s", assembleTimeout, response.State)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(assemblePollInterval):
+		}
+		if response, err = c.assembleReleaseBundle(ctx, orgSlug, version, checksum, checksums); err != nil {
+			return err
+		}
+	}
+}
+
+// assembleReleaseBundle asks the server to assemble a bundle from chunks,
+// or reports the progress of an earlier request.
+func (c *Client) assembleReleaseBundle(ctx context.Context, orgSlug, version, checksum string, chunks []string) (*AssembleResponse, error) {
+	payload := map[string]interface{}{"checksum": checksum, "chunks": chunks}
+	var response AssembleResponse
+	if err := c.post(ctx, releasePath(orgSlug, version)+"assemble/", payload, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// uploadChunks sends chunks to the chunk upload URL, several per request
+// and several requests at a time.
+func (c *Client) uploadChunks(ctx context.Context, options *ChunkUploadOptions, chunks [][]byte, opts BundleUploadOptions) error {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = options.Concurrency
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	batches := batchChunks(chunks, options.ChunksPerRequest, options.MaxRequestSize)
+	target := options.URL
+	if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+		target = c.baseURL + "/" + strings.TrimPrefix(target, "/")
+	}
+	gzipped := false
+	for _, compression := range options.Compression {
+		gzipped = gzipped || compression == "gzip"
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		done     int
+		wg       sync.WaitGroup
+	)
+	work := make(chan [][]byte)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range work {
+				err := withRetry(ctx, uploadAttempts, func() error {
+					return c.uploadChunkBatch(ctx, target, batch, gzipped)
+				})
+
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				done += len(batch)
+				if err == nil && opts.Progress != nil {
+					opts.Progress(done, len(chunks))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, batch := range batches {
+		select {
+		case work <- batch:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+	return firstErr
+}
+
+// uploadChunkBatch sends one multipart request of chunks, each named by
+// its checksum.
+func (c *Client) uploadChunkBatch(ctx context.Context, target string, batch [][]byte, gzipped bool) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	field := "file"
+	if gzipped {
+		field = "file_gzip"
+	}
+	for _, chunk := range batch {
+		part, err := writer.CreateFormFile(field, sha1Hex(chunk))
+		if err != nil {
+			return fmt.Errorf("error creating upload: %w", err)
+		}
+		if gzipped {
+			compressor := gzip.NewWriter(part)
+			if _, err := compressor.Write(chunk); err != nil {
+				return fmt.Errorf("error compressing chunk: %w", err)
+			}
+			if err := compressor.Close(); err != nil {
+				return fmt.Errorf("error compressing chunk: %w", err)
+			}
+		} else if _, err := part.Write(chunk); err != nil {
+			return fmt.Errorf("error creating upload: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("error creating upload: %w", err)
+	}
+
+	req, err := c.newRawRequest(ctx, http.MethodPost, target, &body, writer.FormDataContentType())
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, nil)
+	return err
+}
+
+// splitChunks cuts data into chunks of at most size bytes.
+func splitChunks(data []byte, size int) [][]byte {
+	if size <= 0 {
+		size = 8 << 20
+	}
+	var chunks [][]byte
+	for start := 0; start < len(data); start += size {
+		end := start + size
+		if end > len(data) {
+			end = len(data)
+		}
+		chunks = append(chunks, data[start:end])
+	}
+	return chunks
+}
+
+// batchChunks groups chunks into requests of at most perRequest chunks and
+// maxSize bytes. A chunk larger than maxSize gets a request of its own.
+func batchChunks(chunks [][]byte, perRequest, maxSize int) [][][]byte {
+	if perRequest <= 0 {
+		perRequest = 1
+	}
+	var batches [][][]byte
+	var batch [][]byte
+	size := 0
+	for _, chunk := range chunks {
+		if len(batch) > 0 && (len(batch) >= perRequest || (maxSize > 0 && size+len(chunk) > maxSize)) {
+			batches = append(batches, batch)
+			batch, size = nil, 0
+		}
+		batch = append(batch, chunk)
+		size += len(chunk)
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// sha1Hex returns the hex encoded SHA-1 checksum the chunk upload
+// protocol identifies data by.
+func sha1Hex(data []byte) string {
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
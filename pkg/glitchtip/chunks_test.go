@@ -0,0 +1,172 @@
+package glitchtip
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestUploadReleaseBundle(t *testing.T) {
+	retryDelay, assemblePollInterval = time.Millisecond, time.Millisecond
+	defer func() { retryDelay, assemblePollInterval = 500*time.Millisecond, time.Second }()
+
+	bundle := make([]byte, 100)
+	for i := range bundle {
+		bundle[i] = byte(i)
+	}
+	var (
+		mu         sync.Mutex
+		received   = map[string][]byte{}
+		assembles  int
+		chunkPosts int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/api/0/organizations/acme/chunk-upload/":
+			chunkPosts++
+			// The first request fails and must be retried
+			if chunkPosts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("parsing chunks: %v", err)
+			}
+			for _, header := range r.MultipartForm.File["file_gzip"] {
+				file, _ := header.Open()
+				reader, err := gzip.NewReader(file)
+				if err != nil {
+					t.Fatalf("chunk is not gzipped: %v", err)
+				}
+				data, _ := io.ReadAll(reader)
+				if sha1Hex(data) != header.Filename {
+					t.Errorf("chunk %s has checksum %s", header.Filename, sha1Hex(data))
+				}
+				received[header.Filename] = data
+			}
+		case "/api/0/organizations/acme/releases/1.0/assemble/":
+			var payload struct {
+				Checksum string   `json:"checksum"`
+				Chunks   []string `json:"chunks"`
+			}
+			json.NewDecoder(r.Body).Decode(&payload)
+			if payload.Checksum != sha1Hex(bundle) || len(payload.Chunks) != 4 {
+				t.Errorf("assemble payload = %+v", payload)
+			}
+			assembles++
+			var missing []string
+			for _, sum := range payload.Chunks {
+				if _, ok := received[sum]; !ok {
+					missing = append(missing, sum)
+				}
+			}
+			switch {
+			case len(missing) > 0:
+				json.NewEncoder(w).Encode(AssembleResponse{State: AssembleStateNotFound, MissingChunks: missing})
+			case assembles < 3:
+				json.NewEncoder(w).Encode(AssembleResponse{State: AssembleStateAssembling})
+			default:
+				json.NewEncoder(w).Encode(AssembleResponse{State: AssembleStateOK})
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	options := &ChunkUploadOptions{
+		URL:              server.URL + "/api/0/organizations/acme/chunk-upload/",
+		ChunkSize:        30,
+		ChunksPerRequest: 2,
+		Concurrency:      2,
+		Compression:      []string{"gzip"},
+	}
+	var progress []int
+	err := NewClient(server.URL, "token").UploadReleaseBundle(context.Background(), "acme", "1.0", options, bundle, BundleUploadOptions{
+		Progress: func(done, total int) { progress = append(progress, done) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 100 bytes in chunks of 30 give 4 chunks in 2 requests, plus one retry
+	if len(received) != 4 || chunkPosts != 3 {
+		t.Errorf("received %d chunks in %d requests, want 4 in 3", len(received), chunkPosts)
+	}
+	if assembles != 3 {
+		t.Errorf("assembled %d times, want 3", assembles)
+	}
+	if len(progress) != 2 || progress[1] != 4 {
+		t.Errorf("progress = %v, want two calls ending at 4", progress)
+	}
+}
+
+func TestUploadReleaseBundleFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(AssembleResponse{State: AssembleStateError, Detail: "invalid manifest"})
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL, "token").UploadReleaseBundle(context.Background(), "acme", "1.0", &ChunkUploadOptions{}, []byte("zip"), BundleUploadOptions{})
+	if err == nil || err.Error() != "the server could not process the release bundle: invalid manifest" {
+		t.Errorf("UploadReleaseBundle = %v", err)
+	}
+}
+
+func TestUploadReleaseBundleTimeout(t *testing.T) {
+	assemblePollInterval, assembleTimeout = time.Millisecond, 20*time.Millisecond
+	defer func() { assemblePollInterval, assembleTimeout = time.Second, 5*time.Minute }()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(AssembleResponse{State: AssembleStateAssembling})
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL, "token").UploadReleaseBundle(context.Background(), "acme", "1.0", &ChunkUploadOptions{}, []byte("zip"), BundleUploadOptions{})
+	if err == nil || !strings.Contains(err.Error(), "did not finish assembling") {
+		t.Errorf("UploadReleaseBundle = %v, want a timeout error", err)
+	}
+}
+
+func TestBatchChunks(t *testing.T) {
+	chunks := [][]byte{make([]byte, 4), make([]byte, 4), make([]byte, 4), make([]byte, 10)}
+	batches := batchChunks(chunks, 2, 9)
+	sizes := make([]int, len(batches))
+	for i, batch := range batches {
+		sizes[i] = len(batch)
+	}
+	if len(sizes) != 3 || sizes[0] != 2 || sizes[1] != 1 || sizes[2] != 1 {
+		t.Errorf("batch sizes = %v, want [2 1 1]", sizes)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	retryDelay = time.Millisecond
+	defer func() { retryDelay = 500 * time.Millisecond }()
+
+	calls := 0
+	err := withRetry(context.Background(), 3, func() error {
+		calls++
+		return &APIError{StatusCode: http.StatusBadGateway}
+	})
+	if err == nil || calls != 3 {
+		t.Errorf("withRetry on 502 = %v after %d calls, want an error after 3", err, calls)
+	}
+
+	calls = 0
+	err = withRetry(context.Background(), 3, func() error {
+		calls++
+		return &APIError{StatusCode: http.StatusBadRequest}
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("withRetry on 400 = %v after %d calls, want an error after 1", err, calls)
+	}
+}
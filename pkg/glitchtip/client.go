@@ -0,0 +1,185 @@
+// Package glitchtip provides a typed client for the GlitchTip REST API.
+//
+// The client is shared by every glitchtipctl command, but it has no
+// dependency on the CLI and can be imported by other Go tooling:
+//
+//	client := glitchtip.NewClient("https://glitchtip.example.com", token)
+//	orgs, err := client.ListOrganizations(ctx, nil)
+package glitchtip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultURL is the address of a GlitchTip instance started with the
+// docker-compose file shipped in this repository.
+const DefaultURL = "http://localhost:8000"
+
+// apiPrefix is the path every API endpoint lives under.
+const apiPrefix = "/api/0/"
+
+// Client talks to a single GlitchTip server on behalf of one API token.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// Option configures optional Client settings.
+type Option func(*Client)
+
+// WithHTTPClient replaces the http.Client used for requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient creates a Client for the GlitchTip server at baseURL. The URL
+// may be given with or without the "/api/0" suffix.
+func NewClient(baseURL, token string, opts ...Option) *Client {
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	c := &Client{
+		baseURL:    normalizeBaseURL(baseURL),
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// BaseURL returns the server URL the client sends requests to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// normalizeBaseURL strips trailing slashes and any API path suffix so
+// endpoints can be appended consistently.
+func normalizeBaseURL(baseURL string) string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	baseURL = strings.TrimSuffix(baseURL, "/api/0")
+	baseURL = strings.TrimSuffix(baseURL, "/api")
+	return baseURL
+}
+
+// endpoint builds the absolute URL for an API path such as
+// "organizations/acme/teams/".
+func (c *Client) endpoint(path string, query url.Values) string {
+	u := c.baseURL + apiPrefix + strings.TrimPrefix(path, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// newRequest creates an authenticated request, JSON-encoding body when it
+// is not nil.
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling payload: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint(path, query), reader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// newRawRequest creates an authenticated request for an absolute URL with
+// a body that is not JSON, such as a multipart upload.
+func (c *Client) newRawRequest(ctx context.Context, method, target string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return req, nil
+}
+
+// do sends req and decodes a successful JSON response into v. A nil v
+// discards the response body. Non-2xx responses are returned as *APIError.
+func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return resp, fmt.Errorf("error reading response body: %w", err)
+		}
+		return resp, newAPIError(req, resp.StatusCode, bodyBytes)
+	}
+
+	if v == nil || resp.StatusCode == http.StatusNoContent {
+		return resp, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil && err != io.EOF {
+		return resp, fmt.Errorf("error parsing JSON response: %w", err)
+	}
+	return resp, nil
+}
+
+// send builds and executes a request in one step.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, body, v interface{}) error {
+	req, err := c.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(req, v)
+	return err
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	return c.send(ctx, http.MethodGet, path, query, nil, v)
+}
+
+func (c *Client) post(ctx context.Context, path string, body, v interface{}) error {
+	return c.send(ctx, http.MethodPost, path, nil, body, v)
+}
+
+func (c *Client) put(ctx context.Context, path string, body, v interface{}) error {
+	return c.send(ctx, http.MethodPut, path, nil, body, v)
+}
+
+func (c *Client) delete(ctx context.Context, path string) error {
+	return c.send(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// pathEscape escapes each slug placed into an endpoint path.
+func pathEscape(segment string) string {
+	return url.PathEscape(segment)
+}
@@ -0,0 +1,86 @@
+package glitchtip
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeBaseURL(t *testing.T) {
+	tests := map[string]string{
+		"http://localhost:8000":         "http://localhost:8000",
+		"http://localhost:8000/":        "http://localhost:8000",
+		"http://localhost:8000/api":     "http://localhost:8000",
+		"http://localhost:8000/api/":    "http://localhost:8000",
+		"http://localhost:8000/api/0":   "http://localhost:8000",
+		"http://localhost:8000/api/0/":  "http://localhost:8000",
+		"https://example.com/glitchtip": "https://example.com/glitchtip",
+	}
+	for input, want := range tests {
+		if got := normalizeBaseURL(input); got != want {
+			t.Errorf("normalizeBaseURL(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestClientSendsBearerToken(t *testing.T) {
+	var gotAuth, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotPath = r.URL.Path
+		w.Write([]byte(`{"id":1,"name":"Ann","email":"ann@example.com"}`))
+	}))
+	defer server.Close()
+
+	user, err := NewClient(server.URL+"/api/0/", "secret").GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("GetCurrentUser: %v", err)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer secret")
+	}
+	if gotPath != "/api/0/users/me/" {
+		t.Errorf("path = %q, want /api/0/users/me/", gotPath)
+	}
+	if user.Email != "ann@example.com" {
+		t.Errorf("Email = %q, want ann@example.com", user.Email)
+	}
+}
+
+func TestClientReportsErrorBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"detail":"You do not have permission"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "secret").GetOrganization(context.Background(), "acme")
+	if err == nil {
+		t.Fatal("expected an error for a 403 response")
+	}
+	for _, want := range []string{"403", "You do not have permission", "/api/0/organizations/acme/"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestIDDecodesStringsAndNumbers(t *testing.T) {
+	var decoded struct {
+		A ID `json:"a"`
+		B ID `json:"b"`
+		C ID `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"42","b":17,"c":null}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.A != "42" || decoded.B != "17" || decoded.C != "" {
+		t.Errorf("decoded IDs = %q, %q, %q; want 42, 17, empty", decoded.A, decoded.B, decoded.C)
+	}
+	if err := json.Unmarshal([]byte(`{"a":true}`), &decoded); err == nil {
+		t.Error("expected an error decoding a boolean ID")
+	}
+}
@@ -0,0 +1,17 @@
+package glitchtip
+
+import "context"
+
+// Environment is a deployment environment, such as "production", that
+// events were reported from.
+type Environment struct {
+	ID       ID     `json:"id"`
+	Name     string `json:"name"`
+	IsHidden bool   `json:"isHidden"`
+}
+
+// ListProjectEnvironments returns the environments a project received
+// events from.
+func (c *Client) ListProjectEnvironments(ctx context.Context, orgSlug, projectSlug string) ([]Environment, error) {
+	return newIterator[Environment](ctx, c, projectPath(orgSlug, projectSlug)+"environments/", nil, nil).All()
+}
@@ -0,0 +1,128 @@
+package glitchtip
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ErrNotFound is wrapped by lookups that search a list for a resource and
+// find nothing, so they can be told apart like a 404 response.
+var ErrNotFound = errors.New("not found")
+
+// APIError is returned for every response with a non-2xx status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Method and Endpoint identify the request, e.g. "GET" and
+	// "/api/0/organizations/acme/".
+	Method   string
+	Endpoint string
+	// Detail is GlitchTip's explanation of the error, taken from the
+	// "detail" field or the field errors of the JSON body.
+	Detail string
+	// Body is the raw response body.
+	Body []byte
+}
+
+// Error formats the request, status and detail.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: %d %s", e.Method, e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a failed response body.
+func newAPIError(req *http.Request, statusCode int, body []byte) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Method:     req.Method,
+		Endpoint:   req.URL.Path,
+		Detail:     errorDetail(body),
+		Body:       body,
+	}
+}
+
+// errorDetail extracts a readable message from the error bodies GlitchTip
+// sends: {"detail": "..."}, validation errors such as
+// {"detail": [{"loc": [...], "msg": "..."}]}, or field errors like
+// {"slug": ["already exists"]}. Other bodies are returned trimmed.
+func errorDetail(body []byte) string {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return strings.TrimSpace(string(body))
+	}
+
+	if detail, ok := payload["detail"]; ok {
+		switch d := detail.(type) {
+		case string:
+			return d
+		case []interface{}:
+			var messages []string
+			for _, item := range d {
+				messages = append(messages, validationMessage(item))
+			}
+			return strings.Join(messages, "; ")
+		}
+	}
+
+	var fields []string
+	for field := range payload {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	var messages []string
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%s: %s", field, flattenMessages(payload[field])))
+	}
+	return strings.Join(messages, "; ")
+}
+
+// validationMessage formats one entry of a validation error list.
+func validationMessage(item interface{}) string {
+	entry, ok := item.(map[string]interface{})
+	if !ok {
+		return fmt.Sprintf("%v", item)
+	}
+	msg := fmt.Sprintf("%v", entry["msg"])
+	if loc, ok := entry["loc"].([]interface{}); ok && len(loc) > 0 {
+		parts := make([]string, len(loc))
+		for i, part := range loc {
+			parts[i] = fmt.Sprintf("%v", part)
+		}
+		msg = strings.Join(parts, ".") + ": " + msg
+	}
+	return msg
+}
+
+// flattenMessages joins a string or list of strings.
+func flattenMessages(value interface{}) string {
+	if list, ok := value.([]interface{}); ok {
+		parts := make([]string, len(list))
+		for i, part := range list {
+			parts[i] = fmt.Sprintf("%v", part)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// StatusCode returns the HTTP status of an APIError anywhere in err's
+// chain, or 0 when err did not come from an API response.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is a 404 response or wraps ErrNotFound.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound || errors.Is(err, ErrNotFound)
+}
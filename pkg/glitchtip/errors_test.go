@@ -0,0 +1,45 @@
+package glitchtip
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"detail":"Not found."}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "token").GetProject(context.Background(), "acme", "web")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error %v is not an *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Method != http.MethodGet ||
+		apiErr.Endpoint != "/api/0/projects/acme/web/" || apiErr.Detail != "Not found." {
+		t.Errorf("unexpected APIError %+v", apiErr)
+	}
+	if !IsNotFound(err) {
+		t.Error("IsNotFound returned false for a 404")
+	}
+}
+
+func TestErrorDetail(t *testing.T) {
+	tests := map[string]string{
+		`{"detail":"Invalid token."}`:                                           "Invalid token.",
+		`{"detail":[{"loc":["body","payload","slug"],"msg":"field required"}]}`: "body.payload.slug: field required",
+		`{"slug":["already exists"],"name":["too long","invalid"]}`:             "name: too long, invalid; slug: already exists",
+		`<html>Bad Gateway</html>`:                                              "<html>Bad Gateway</html>",
+		``:                                                                      "",
+	}
+	for body, want := range tests {
+		if got := errorDetail([]byte(body)); got != want {
+			t.Errorf("errorDetail(%q) = %q, want %q", body, got, want)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package glitchtip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Event entry types.
+const (
+	EntryException   = "exception"
+	EntryBreadcrumbs = "breadcrumbs"
+	EntryRequest     = "request"
+	EntryMessage     = "message"
+)
+
+// Event is a single error or message reported by an SDK. Besides the
+// decoded fields it keeps the JSON it was decoded from, so encoding an
+// event again returns the full payload including fields not modelled here.
+type Event struct {
+	ID           ID                     `json:"id"`
+	EventID      string                 `json:"eventID"`
+	GroupID      ID                     `json:"groupID"`
+	ProjectID    ID                     `json:"projectID"`
+	Title        string                 `json:"title"`
+	Message      string                 `json:"message"`
+	Culprit      string                 `json:"culprit"`
+	Platform     string                 `json:"platform"`
+	Type         string                 `json:"type"`
+	DateCreated  string                 `json:"dateCreated"`
+	DateReceived string                 `json:"dateReceived"`
+	Tags         []EventTag             `json:"tags"`
+	Contexts     map[string]interface{} `json:"contexts"`
+	User         map[string]interface{} `json:"user"`
+	Entries      []EventEntry           `json:"entries"`
+
+	raw json.RawMessage
+}
+
+// EventTag is a key/value tag attached to an event.
+type EventTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// EventEntry is one section of an event, such as its exception or its
+// breadcrumbs. Data is decoded by the typed accessors of Event.
+type EventEntry struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ExceptionEntry is the data of an exception entry. Values are ordered
+// from the first cause to the exception that was finally raised.
+type ExceptionEntry struct {
+	Values []Exception `json:"values"`
+}
+
+// Exception is one exception of a chain.
+type Exception struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Module     string      `json:"module"`
+	Mechanism  *Mechanism  `json:"mechanism,omitempty"`
+	Stacktrace *Stacktrace `json:"stacktrace,omitempty"`
+}
+
+// Mechanism describes how an exception was captured.
+type Mechanism struct {
+	Type    string `json:"type"`
+	Handled *bool  `json:"handled,omitempty"`
+}
+
+// Stacktrace holds the frames of an exception, oldest call first.
+type Stacktrace struct {
+	Frames []Frame `json:"frames"`
+}
+
+// Frame is one stack frame.
+type Frame struct {
+	Filename string                 `json:"filename"`
+	AbsPath  string                 `json:"absPath"`
+	Module   string                 `json:"module"`
+	Package  string                 `json:"package"`
+	Function string                 `json:"function"`
+	LineNo   int                    `json:"lineNo"`
+	ColNo    int                    `json:"colNo"`
+	InApp    bool                   `json:"inApp"`
+	Context  []ContextLine          `json:"context"`
+	Vars     map[string]interface{} `json:"vars,omitempty"`
+}
+
+// ContextLine is a line of source code around a frame, encoded by the API
+// as a [line number, code] pair.
+type ContextLine struct {
+	LineNo int
+	Code   string
+}
+
+// UnmarshalJSON decodes a [line number, code] pair.
+func (l *ContextLine) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("context line must have 2 elements, got %d", len(pair))
+	}
+	if err := json.Unmarshal(pair[0], &l.LineNo); err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], &l.Code)
+}
+
+// MarshalJSON encodes the line as a [line number, code] pair.
+func (l ContextLine) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{l.LineNo, l.Code})
+}
+
+// BreadcrumbsEntry is the data of a breadcrumbs entry, oldest first.
+type BreadcrumbsEntry struct {
+	Values []Breadcrumb `json:"values"`
+}
+
+// Breadcrumb is an action recorded before the event.
+type Breadcrumb struct {
+	Timestamp interface{}            `json:"timestamp"`
+	Type      string                 `json:"type"`
+	Category  string                 `json:"category"`
+	Level     string                 `json:"level"`
+	Message   string                 `json:"message"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// RequestEntry is the data of a request entry.
+type RequestEntry struct {
+	URL      string                 `json:"url"`
+	Method   string                 `json:"method"`
+	Query    interface{}            `json:"query,omitempty"`
+	Fragment string                 `json:"fragment,omitempty"`
+	Data     interface{}            `json:"data,omitempty"`
+	Headers  [][]string             `json:"headers,omitempty"`
+	Env      map[string]interface{} `json:"env,omitempty"`
+}
+
+// MessageEntry is the data of a message entry.
+type MessageEntry struct {
+	Formatted string `json:"formatted"`
+	Message   string `json:"message"`
+}
+
+// eventAlias has the fields of Event without its JSON methods.
+type eventAlias Event
+
+// UnmarshalJSON decodes an event and keeps the original JSON.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var alias eventAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+	*e = Event(alias)
+	e.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON returns the JSON the event was decoded from, or encodes its
+// fields when it was built in code.
+func (e Event) MarshalJSON() ([]byte, error) {
+	if e.raw != nil {
+		return e.raw, nil
+	}
+	return json.Marshal(eventAlias(e))
+}
+
+// Entry returns the raw data of the first entry of the given type.
+func (e *Event) Entry(entryType string) (json.RawMessage, bool) {
+	for _, entry := range e.Entries {
+		if entry.Type == entryType {
+			return entry.Data, true
+		}
+	}
+	return nil, false
+}
+
+// Exception returns the exception entry, or nil when there is none.
+func (e *Event) Exception() (*ExceptionEntry, error) {
+	return decodeEntry[ExceptionEntry](e, EntryException)
+}
+
+// Breadcrumbs returns the breadcrumbs entry, or nil when there is none.
+func (e *Event) Breadcrumbs() (*BreadcrumbsEntry, error) {
+	return decodeEntry[BreadcrumbsEntry](e, EntryBreadcrumbs)
+}
+
+// Request returns the request entry, or nil when there is none.
+func (e *Event) Request() (*RequestEntry, error) {
+	return decodeEntry[RequestEntry](e, EntryRequest)
+}
+
+// MessageEntry returns the message entry, or nil when there is none.
+func (e *Event) MessageEntry() (*MessageEntry, error) {
+	return decodeEntry[MessageEntry](e, EntryMessage)
+}
+
+// Tag returns the value of a tag.
+func (e *Event) Tag(key string) (string, bool) {
+	for _, tag := range e.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// decodeEntry decodes the data of the first entry of the given type.
+func decodeEntry[T any](e *Event, entryType string) (*T, error) {
+	data, ok := e.Entry(entryType)
+	if !ok || len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var entry T
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("error parsing %s entry: %w", entryType, err)
+	}
+	return &entry, nil
+}
+
+// IterIssueEvents pages through the events of an issue, newest first.
+func (c *Client) IterIssueEvents(ctx context.Context, issueID string, opts *ListOptions) *Iterator[Event] {
+	return newIterator[Event](ctx, c, issuePath(issueID)+"events/", nil, opts)
+}
+
+// ListIssueEvents returns the events of an issue, newest first.
+func (c *Client) ListIssueEvents(ctx context.Context, issueID string, opts *ListOptions) ([]Event, error) {
+	return c.IterIssueEvents(ctx, issueID, opts).All()
+}
+
+// GetLatestIssueEvent returns the most recent event of an issue.
+func (c *Client) GetLatestIssueEvent(ctx context.Context, issueID string) (*Event, error) {
+	return c.getEvent(ctx, issuePath(issueID)+"events/latest/")
+}
+
+// GetIssueEvent returns one event of an issue.
+func (c *Client) GetIssueEvent(ctx context.Context, issueID, eventID string) (*Event, error) {
+	return c.getEvent(ctx, issuePath(issueID)+"events/"+pathEscape(eventID)+"/")
+}
+
+// GetProjectEvent returns one event of a project.
+func (c *Client) GetProjectEvent(ctx context.Context, orgSlug, projectSlug, eventID string) (*Event, error) {
+	return c.getEvent(ctx, projectPath(orgSlug, projectSlug)+"events/"+pathEscape(eventID)+"/")
+}
+
+func (c *Client) getEvent(ctx context.Context, path string) (*Event, error) {
+	var event Event
+	if err := c.get(ctx, path, nil, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
@@ -0,0 +1,121 @@
+package glitchtip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func loadEvent(t *testing.T) *Event {
+	t.Helper()
+	data, err := os.ReadFile("testdata/event.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var event Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		t.Fatalf("decoding event: %v", err)
+	}
+	return &event
+}
+
+func TestEventEntries(t *testing.T) {
+	event := loadEvent(t)
+
+	if event.GroupID != "101" || event.EventID == "" {
+		t.Errorf("unexpected IDs: %q %q", event.GroupID, event.EventID)
+	}
+	if release, _ := event.Tag("release"); release != "1.4.0" {
+		t.Errorf("release tag = %q", release)
+	}
+
+	exception, err := event.Exception()
+	if err != nil {
+		t.Fatalf("Exception: %v", err)
+	}
+	if len(exception.Values) != 2 || exception.Values[1].Type != "ValueError" {
+		t.Fatalf("unexpected exception chain: %+v", exception.Values)
+	}
+	frame := exception.Values[1].Stacktrace.Frames[1]
+	if !frame.InApp || frame.LineNo != 42 || len(frame.Context) != 3 || frame.Context[1].Code != "    total = int(qty) * price" {
+		t.Errorf("unexpected frame: %+v", frame)
+	}
+	if handled := exception.Values[1].Mechanism.Handled; handled == nil || *handled {
+		t.Errorf("mechanism should be unhandled")
+	}
+
+	breadcrumbs, err := event.Breadcrumbs()
+	if err != nil || len(breadcrumbs.Values) != 2 {
+		t.Fatalf("Breadcrumbs: %v %+v", err, breadcrumbs)
+	}
+	request, err := event.Request()
+	if err != nil || request.Method != "POST" || len(request.Headers) != 2 {
+		t.Fatalf("Request: %v %+v", err, request)
+	}
+	message, err := event.MessageEntry()
+	if err != nil || message != nil {
+		t.Errorf("MessageEntry = %+v, %v; want nil", message, err)
+	}
+}
+
+func TestEventKeepsOriginalJSON(t *testing.T) {
+	event := loadEvent(t)
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The sdk field is not modelled but must survive a round trip.
+	if !bytes.Contains(data, []byte(`"sentry.python"`)) {
+		t.Errorf("encoded event lost unmodelled fields: %s", data)
+	}
+
+	built, err := json.Marshal(Event{Title: "built"})
+	if err != nil || !bytes.Contains(built, []byte(`"title":"built"`)) {
+		t.Errorf("encoding a built event = %s, %v", built, err)
+	}
+}
+
+func TestEventEndpoints(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/api/0/issues/101/events/" {
+			w.Write([]byte(`[{"eventID":"a"},{"eventID":"b"}]`))
+			return
+		}
+		w.Write([]byte(`{"eventID":"a"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	events, err := client.ListIssueEvents(ctx, "101", nil)
+	if err != nil || len(events) != 2 {
+		t.Fatalf("ListIssueEvents = %v, %v", events, err)
+	}
+	if _, err := client.GetLatestIssueEvent(ctx, "101"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetIssueEvent(ctx, "101", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetProjectEvent(ctx, "acme", "web", "a"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"/api/0/issues/101/events/",
+		"/api/0/issues/101/events/latest/",
+		"/api/0/issues/101/events/a/",
+		"/api/0/projects/acme/web/events/a/",
+	}
+	for i := range want {
+		if i >= len(paths) || paths[i] != want[i] {
+			t.Errorf("request %d = %v, want %s", i, paths, want[i])
+		}
+	}
+}
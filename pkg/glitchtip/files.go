@@ -0,0 +1,78 @@
+package glitchtip
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"path"
+)
+
+// ReleaseFile is an artifact of a release, such as a minified script or
+// its source map, stored under the URL it is served from.
+type ReleaseFile struct {
+	ID          ID                `json:"id"`
+	Name        string            `json:"name"`
+	Dist        string            `json:"dist"`
+	Headers     map[string]string `json:"headers"`
+	Size        int64             `json:"size"`
+	SHA1        string            `json:"sha1"`
+	DateCreated string            `json:"dateCreated"`
+}
+
+// ReleaseFileUpload is one file sent to UploadReleaseFile.
+type ReleaseFileUpload struct {
+	// Name is the URL the file is served from, e.g. "~/static/js/app.js".
+	Name    string
+	Dist    string
+	Headers map[string]string
+	Content []byte
+}
+
+// ListReleaseFiles returns the files of a release.
+func (c *Client) ListReleaseFiles(ctx context.Context, orgSlug, version string) ([]ReleaseFile, error) {
+	return newIterator[ReleaseFile](ctx, c, releasePath(orgSlug, version)+"files/", nil, nil).All()
+}
+
+// UploadReleaseFile uploads a single release file. Use UploadReleaseBundle
+// for many files, which servers with chunked uploads handle faster.
+// Failed uploads are retried.
+func (c *Client) UploadReleaseFile(ctx context.Context, orgSlug, version string, file ReleaseFileUpload) (*ReleaseFile, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("name", file.Name)
+	if file.Dist != "" {
+		writer.WriteField("dist", file.Dist)
+	}
+	for key, value := range file.Headers {
+		writer.WriteField("header", key+":"+value)
+	}
+	part, err := writer.CreateFormFile("file", path.Base(file.Name))
+	if err != nil {
+		return nil, fmt.Errorf("error creating upload: %w", err)
+	}
+	part.Write(file.Content)
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error creating upload: %w", err)
+	}
+
+	var created ReleaseFile
+	err = withRetry(ctx, uploadAttempts, func() error {
+		req, err := c.newRawRequest(ctx, http.MethodPost, c.endpoint(releasePath(orgSlug, version)+"files/", nil), bytes.NewReader(body.Bytes()), writer.FormDataContentType())
+		if err != nil {
+			return err
+		}
+		_, err = c.do(req, &created)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteReleaseFile deletes a file of a release.
+func (c *Client) DeleteReleaseFile(ctx context.Context, orgSlug, version, fileID string) error {
+	return c.delete(ctx, releasePath(orgSlug, version)+"files/"+pathEscape(fileID)+"/")
+}
@@ -0,0 +1,77 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUploadReleaseFile(t *testing.T) {
+	attempts := 0
+	var fields map[string][]string
+	var content string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if path := r.URL.EscapedPath(); path != "/api/0/organizations/acme/releases/1.0+build/files/" {
+			t.Errorf("path = %s", path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatal(err)
+		}
+		fields = r.MultipartForm.Value
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(file)
+		content = header.Filename + ":" + string(data)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"7","name":"~/app.js","size":7}`))
+	}))
+	defer server.Close()
+
+	retryDelay = time.Millisecond
+	defer func() { retryDelay = 500 * time.Millisecond }()
+
+	file, err := NewClient(server.URL, "token").UploadReleaseFile(context.Background(), "acme", "1.0+build", ReleaseFileUpload{
+		Name:    "~/app.js",
+		Dist:    "42",
+		Headers: map[string]string{"Sourcemap": "app.js.map"},
+		Content: []byte("app();\n"),
+	})
+	if err != nil || file.ID != "7" {
+		t.Fatalf("UploadReleaseFile = %+v, %v", file, err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want a retry after the 502", attempts)
+	}
+	if fields["name"][0] != "~/app.js" || fields["dist"][0] != "42" || fields["header"][0] != "Sourcemap:app.js.map" {
+		t.Errorf("fields = %v", fields)
+	}
+	if content != "app.js:app();\n" {
+		t.Errorf("file = %q", content)
+	}
+}
+
+func TestDeleteReleaseFile(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "token").DeleteReleaseFile(context.Background(), "acme", "1.0", "7"); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodDelete || path != "/api/0/organizations/acme/releases/1.0/files/7/" {
+		t.Errorf("request = %s %s", method, path)
+	}
+}
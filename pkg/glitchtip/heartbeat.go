@@ -0,0 +1,37 @@
+package glitchtip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// heartbeatAttempts is how often a heartbeat is tried before giving up.
+const heartbeatAttempts = 3
+
+// HeartbeatURL returns the URL a heartbeat monitor is pinged at.
+func (c *Client) HeartbeatURL(orgSlug string, monitor Monitor) (string, error) {
+	if monitor.MonitorType != MonitorTypeHeartbeat {
+		return "", fmt.Errorf("monitor %q is not a heartbeat monitor", monitor.Name)
+	}
+	if monitor.HeartbeatEndpoint != "" {
+		return monitor.HeartbeatEndpoint, nil
+	}
+	if monitor.EndpointID != "" {
+		return c.endpoint(organizationPath(orgSlug)+"heartbeat_check/"+pathEscape(monitor.EndpointID)+"/", nil), nil
+	}
+	return "", fmt.Errorf("monitor %q has no heartbeat endpoint", monitor.Name)
+}
+
+// SendHeartbeat pings a heartbeat endpoint. The endpoint needs no API
+// token, so none is sent. Failed pings are retried.
+func (c *Client) SendHeartbeat(ctx context.Context, heartbeatURL string) error {
+	return withRetry(ctx, heartbeatAttempts, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, heartbeatURL, nil)
+		if err != nil {
+			return fmt.Errorf("error creating request: %w", err)
+		}
+		_, err = c.do(req, nil)
+		return err
+	})
+}
@@ -0,0 +1,214 @@
+package glitchtip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Issue statuses.
+const (
+	IssueStatusUnresolved = "unresolved"
+	IssueStatusResolved   = "resolved"
+	IssueStatusIgnored    = "ignored"
+)
+
+// IssueSorts maps the sort orders accepted by the CLI to the values the
+// issues endpoint expects.
+var IssueSorts = map[string]string{
+	"date":     "-last_seen",
+	"new":      "-created",
+	"priority": "-priority",
+	"freq":     "-count",
+}
+
+// Issue is a group of similar events.
+type Issue struct {
+	ID          ID                     `json:"id"`
+	ShortID     string                 `json:"shortId"`
+	Title       string                 `json:"title"`
+	Culprit     string                 `json:"culprit"`
+	Type        string                 `json:"type"`
+	Level       string                 `json:"level"`
+	Status      string                 `json:"status"`
+	Count       json.Number            `json:"count"`
+	UserCount   int                    `json:"userCount"`
+	NumComments int                    `json:"numComments"`
+	FirstSeen   string                 `json:"firstSeen"`
+	LastSeen    string                 `json:"lastSeen"`
+	Permalink   string                 `json:"permalink,omitempty"`
+	IsPublic    bool                   `json:"isPublic"`
+	HasSeen     bool                   `json:"hasSeen"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Project     *IssueProject          `json:"project,omitempty"`
+	AssignedTo  *IssueAssignee         `json:"assignedTo,omitempty"`
+}
+
+// IssueAssignee is the user an issue is assigned to.
+type IssueAssignee struct {
+	Type  string `json:"type"`
+	ID    ID     `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// IssueProject is the abbreviated project nested in an issue.
+type IssueProject struct {
+	ID       ID     `json:"id"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	Platform string `json:"platform"`
+}
+
+// IssueUpdate is the payload of a bulk issue update. AssignedTo takes an
+// assignee as returned by AssignUser, and an empty string unassigns the
+// issues.
+type IssueUpdate struct {
+	Status     string  `json:"status,omitempty"`
+	AssignedTo *string `json:"assignedTo,omitempty"`
+}
+
+// AssignUser returns the assignee of an IssueUpdate for a user.
+func AssignUser(userID ID) *string {
+	assignee := "user:" + userID.String()
+	return &assignee
+}
+
+// IssueFilter selects the issues returned by IterIssues.
+type IssueFilter struct {
+	// Query uses GlitchTip's search syntax, e.g.
+	// "is:unresolved level:error release:1.2.0 timeout".
+	Query string
+	// Sort is one of the values of IssueSorts, e.g. "-last_seen".
+	Sort string
+	// ProjectIDs restricts the list to the given projects.
+	ProjectIDs []ID
+	// Environments restricts the list to events from these environments.
+	Environments []string
+	// Start and End restrict the list to issues seen in this time range.
+	Start time.Time
+	End   time.Time
+}
+
+// values encodes the filter as query parameters of the issues endpoint.
+func (f *IssueFilter) values() url.Values {
+	query := url.Values{}
+	if f == nil {
+		return query
+	}
+	if q := strings.TrimSpace(f.Query); q != "" {
+		query.Set("query", q)
+	}
+	if f.Sort != "" {
+		query.Set("sort", f.Sort)
+	}
+	for _, id := range f.ProjectIDs {
+		query.Add("project", id.String())
+	}
+	for _, environment := range f.Environments {
+		query.Add("environment", environment)
+	}
+	if !f.Start.IsZero() {
+		query.Set("start", f.Start.UTC().Format(time.RFC3339))
+	}
+	if !f.End.IsZero() {
+		query.Set("end", f.End.UTC().Format(time.RFC3339))
+	}
+	return query
+}
+
+// IterIssues pages through the issues of an organization that match filter.
+func (c *Client) IterIssues(ctx context.Context, orgSlug string, filter *IssueFilter, opts *ListOptions) *Iterator[Issue] {
+	return newIterator[Issue](ctx, c, issuesPath(orgSlug), filter.values(), opts)
+}
+
+// ListIssues returns the issues of an organization that match filter.
+func (c *Client) ListIssues(ctx context.Context, orgSlug string, filter *IssueFilter, opts *ListOptions) ([]Issue, error) {
+	return c.IterIssues(ctx, orgSlug, filter, opts).All()
+}
+
+// CountIssues returns the number of issues of an organization that match
+// filter. It reads the total from the X-Hits header and only pages through
+// the issues when the server does not send one.
+func (c *Client) CountIssues(ctx context.Context, orgSlug string, filter *IssueFilter) (int, error) {
+	query := filter.values()
+	query.Set("limit", "1")
+	req, err := c.newRequest(ctx, http.MethodGet, issuesPath(orgSlug), query, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.do(req, nil)
+	if err != nil {
+		return 0, err
+	}
+	if hits, err := strconv.Atoi(resp.Header.Get("X-Hits")); err == nil {
+		return hits, nil
+	}
+
+	count := 0
+	it := c.IterIssues(ctx, orgSlug, filter, nil)
+	for it.Next() {
+		count++
+	}
+	return count, it.Err()
+}
+
+// GetIssue returns a single issue by ID.
+func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
+	var issue Issue
+	if err := c.get(ctx, issuePath(id), nil, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// UpdateIssues applies update to every issue in ids through the bulk
+// issues endpoint of an organization.
+func (c *Client) UpdateIssues(ctx context.Context, orgSlug string, ids []ID, update IssueUpdate) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return c.send(ctx, http.MethodPut, issuesPath(orgSlug), issueIDs(ids), update, nil)
+}
+
+// DeleteIssues deletes every issue in ids through the bulk issues endpoint
+// of an organization.
+func (c *Client) DeleteIssues(ctx context.Context, orgSlug string, ids []ID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return c.send(ctx, http.MethodDelete, issuesPath(orgSlug), issueIDs(ids), nil, nil)
+}
+
+// issueIDs selects issues by ID on the bulk issues endpoint.
+func issueIDs(ids []ID) url.Values {
+	query := url.Values{}
+	for _, id := range ids {
+		query.Add("id", id.String())
+	}
+	return query
+}
+
+// ParseIssueSort converts a sort order accepted by the CLI (date, new,
+// priority, freq) to the value expected by the issues endpoint.
+func ParseIssueSort(sort string) (string, error) {
+	if value, ok := IssueSorts[sort]; ok {
+		return value, nil
+	}
+	return "", fmt.Errorf("unknown sort order %q, expected one of date, new, priority, freq", sort)
+}
+
+// issuesPath is the issue list endpoint of an organization.
+func issuesPath(orgSlug string) string {
+	return organizationPath(orgSlug) + "issues/"
+}
+
+// issuePath is the detail endpoint of an issue.
+func issuePath(id string) string {
+	return "issues/" + pathEscape(id) + "/"
+}
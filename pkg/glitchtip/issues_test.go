@@ -0,0 +1,134 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListIssuesSendsFilter(t *testing.T) {
+	var got url.Values
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		got = r.URL.Query()
+		w.Write([]byte(`[{"id":"42","shortId":"WEB-1","title":"TypeError","level":"error","status":"unresolved","count":"17","project":{"id":3,"slug":"web"}}]`))
+	}))
+	defer server.Close()
+
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	issues, err := NewClient(server.URL, "token").ListIssues(context.Background(), "acme", &IssueFilter{
+		Query:        "is:unresolved level:error",
+		Sort:         "-count",
+		ProjectIDs:   []ID{"3", "4"},
+		Environments: []string{"production"},
+		Start:        start,
+	}, &ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListIssues: %v", err)
+	}
+
+	if gotPath != "/api/0/organizations/acme/issues/" {
+		t.Errorf("path = %q", gotPath)
+	}
+	want := url.Values{
+		"query":       {"is:unresolved level:error"},
+		"sort":        {"-count"},
+		"project":     {"3", "4"},
+		"environment": {"production"},
+		"start":       {"2024-05-01T12:00:00Z"},
+		"limit":       {"10"},
+	}
+	for key, values := range want {
+		if len(got[key]) != len(values) {
+			t.Errorf("%s = %v, want %v", key, got[key], values)
+			continue
+		}
+		for i := range values {
+			if got[key][i] != values[i] {
+				t.Errorf("%s = %v, want %v", key, got[key], values)
+			}
+		}
+	}
+	if _, ok := got["end"]; ok {
+		t.Errorf("end should not be sent when unset, got %v", got["end"])
+	}
+
+	if len(issues) != 1 || issues[0].ID != "42" || issues[0].Count.String() != "17" || issues[0].Project.ID != "3" {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestParseIssueSort(t *testing.T) {
+	for sort, want := range IssueSorts {
+		got, err := ParseIssueSort(sort)
+		if err != nil || got != want {
+			t.Errorf("ParseIssueSort(%q) = %q, %v; want %q", sort, got, err, want)
+		}
+	}
+	if _, err := ParseIssueSort("oldest"); err == nil {
+		t.Error("ParseIssueSort(oldest) should fail")
+	}
+}
+
+func TestBulkIssueRequests(t *testing.T) {
+	type request struct {
+		method string
+		ids    []string
+		body   string
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/0/organizations/acme/issues/" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{r.Method, r.URL.Query()["id"], string(body)})
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(`{"status":"resolved"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	if err := client.UpdateIssues(ctx, "acme", []ID{"1", "2"}, IssueUpdate{Status: IssueStatusResolved}); err != nil {
+		t.Fatalf("UpdateIssues: %v", err)
+	}
+	if err := client.DeleteIssues(ctx, "acme", []ID{"3"}); err != nil {
+		t.Fatalf("DeleteIssues: %v", err)
+	}
+	if err := client.UpdateIssues(ctx, "acme", []ID{"4"}, IssueUpdate{AssignedTo: AssignUser("7")}); err != nil {
+		t.Fatalf("UpdateIssues: %v", err)
+	}
+	unassign := ""
+	if err := client.UpdateIssues(ctx, "acme", []ID{"4"}, IssueUpdate{AssignedTo: &unassign}); err != nil {
+		t.Fatalf("UpdateIssues: %v", err)
+	}
+	if err := client.UpdateIssues(ctx, "acme", nil, IssueUpdate{Status: IssueStatusIgnored}); err != nil {
+		t.Fatalf("UpdateIssues without IDs: %v", err)
+	}
+
+	if len(requests) != 4 {
+		t.Fatalf("got %d requests, want 4 (no request without IDs)", len(requests))
+	}
+	if got := requests[0]; got.method != http.MethodPut || strings.Join(got.ids, ",") != "1,2" || got.body != `{"status":"resolved"}` {
+		t.Errorf("update request = %+v", got)
+	}
+	if got := requests[1]; got.method != http.MethodDelete || strings.Join(got.ids, ",") != "3" {
+		t.Errorf("delete request = %+v", got)
+	}
+	if got := requests[2]; got.body != `{"assignedTo":"user:7"}` {
+		t.Errorf("assign request = %+v", got)
+	}
+	if got := requests[3]; got.body != `{"assignedTo":""}` {
+		t.Errorf("unassign request = %+v", got)
+	}
+}
@@ -0,0 +1,117 @@
+package glitchtip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ProjectKey is a client key (DSN) that SDKs use to send events to a
+// project.
+type ProjectKey struct {
+	ID          ID            `json:"id"`
+	Name        string        `json:"name"`
+	Label       string        `json:"label"`
+	Public      string        `json:"public"`
+	Secret      string        `json:"secret"`
+	ProjectID   ID            `json:"projectId"`
+	IsActive    bool          `json:"isActive"`
+	DateCreated string        `json:"dateCreated"`
+	DSN         ProjectKeyDSN `json:"dsn"`
+	RateLimit   *KeyRateLimit `json:"rateLimit"`
+}
+
+// ProjectKeyDSN holds the endpoints derived from a client key.
+type ProjectKeyDSN struct {
+	Public   string `json:"public"`
+	Secret   string `json:"secret"`
+	CSP      string `json:"csp"`
+	Security string `json:"security"`
+	Minidump string `json:"minidump"`
+}
+
+// KeyRateLimit caps the number of events accepted through a key per
+// window of seconds. A zero Count means no limit.
+type KeyRateLimit struct {
+	Window int `json:"window"`
+	Count  int `json:"count"`
+}
+
+// ProjectKeyRequest is the payload for creating or updating a client key.
+// Nil fields are left unchanged by updates.
+type ProjectKeyRequest struct {
+	Name      string        `json:"name,omitempty"`
+	IsActive  *bool         `json:"isActive,omitempty"`
+	RateLimit *KeyRateLimit `json:"rateLimit,omitempty"`
+}
+
+// ListProjectKeys returns the client keys of a project.
+func (c *Client) ListProjectKeys(ctx context.Context, orgSlug, projectSlug string) ([]ProjectKey, error) {
+	return newIterator[ProjectKey](ctx, c, projectPath(orgSlug, projectSlug)+"keys/", nil, nil).All()
+}
+
+// GetProjectKey returns a single client key by its ID, the public key.
+func (c *Client) GetProjectKey(ctx context.Context, orgSlug, projectSlug, keyID string) (*ProjectKey, error) {
+	var key ProjectKey
+	if err := c.get(ctx, projectKeyPath(orgSlug, projectSlug, keyID), nil, &key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindProjectKey looks a client key up by ID or by name. The error wraps
+// ErrNotFound when no key matches.
+func (c *Client) FindProjectKey(ctx context.Context, orgSlug, projectSlug, idOrName string) (*ProjectKey, error) {
+	keys, err := c.ListProjectKeys(ctx, orgSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.ID.String() == idOrName {
+			return &key, nil
+		}
+	}
+	var found *ProjectKey
+	for i, key := range keys {
+		if strings.EqualFold(key.Name, idOrName) {
+			if found != nil {
+				return nil, fmt.Errorf("project %q has several keys named %q, use the key ID instead", projectSlug, idOrName)
+			}
+			found = &keys[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no key %q in project %q: %w", idOrName, projectSlug, ErrNotFound)
+	}
+	return found, nil
+}
+
+// CreateProjectKey creates a client key for a project.
+func (c *Client) CreateProjectKey(ctx context.Context, orgSlug, projectSlug string, payload ProjectKeyRequest) (*ProjectKey, error) {
+	var key ProjectKey
+	if err := c.post(ctx, projectPath(orgSlug, projectSlug)+"keys/", payload, &key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// UpdateProjectKey renames, enables or disables a client key, or changes
+// its rate limit.
+func (c *Client) UpdateProjectKey(ctx context.Context, orgSlug, projectSlug, keyID string, payload ProjectKeyRequest) (*ProjectKey, error) {
+	var key ProjectKey
+	if err := c.put(ctx, projectKeyPath(orgSlug, projectSlug, keyID), payload, &key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// DeleteProjectKey deletes a client key. SDKs using its DSN can no longer
+// send events.
+func (c *Client) DeleteProjectKey(ctx context.Context, orgSlug, projectSlug, keyID string) error {
+	return c.delete(ctx, projectKeyPath(orgSlug, projectSlug, keyID))
+}
+
+// projectKeyPath is the detail endpoint of a client key.
+func projectKeyPath(orgSlug, projectSlug, keyID string) string {
+	return projectPath(orgSlug, projectSlug) + "keys/" + pathEscape(keyID) + "/"
+}
@@ -0,0 +1,60 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindProjectKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":"a1","name":"Default"},{"id":"b2","name":"backend"},{"id":"c3","name":"backend"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+
+	key, err := client.FindProjectKey(ctx, "acme", "web", "default")
+	if err != nil || key.ID != "a1" {
+		t.Errorf("FindProjectKey by name = %+v, %v", key, err)
+	}
+	key, err = client.FindProjectKey(ctx, "acme", "web", "c3")
+	if err != nil || key.ID != "c3" {
+		t.Errorf("FindProjectKey by ID = %+v, %v", key, err)
+	}
+	if _, err := client.FindProjectKey(ctx, "acme", "web", "backend"); err == nil {
+		t.Error("FindProjectKey of an ambiguous name succeeded")
+	}
+	if _, err := client.FindProjectKey(ctx, "acme", "web", "frontend"); !IsNotFound(err) {
+		t.Errorf("FindProjectKey of an unknown key = %v, want a not found error", err)
+	}
+}
+
+func TestUpdateProjectKey(t *testing.T) {
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(`{"id":"a1","name":"Default","isActive":false}`))
+	}))
+	defer server.Close()
+
+	active := false
+	key, err := NewClient(server.URL, "token").UpdateProjectKey(context.Background(), "acme", "web", "a1", ProjectKeyRequest{
+		IsActive:  &active,
+		RateLimit: &KeyRateLimit{Count: 100, Window: 60},
+	})
+	if err != nil || key.IsActive {
+		t.Fatalf("UpdateProjectKey = %+v, %v", key, err)
+	}
+	if method != http.MethodPut || path != "/api/0/projects/acme/web/keys/a1/" {
+		t.Errorf("request = %s %s", method, path)
+	}
+	if want := `{"isActive":false,"rateLimit":{"window":60,"count":100}}`; body != want {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+}
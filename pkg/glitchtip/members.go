@@ -0,0 +1,131 @@
+package glitchtip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Organization roles, from least to most privileged.
+const (
+	RoleMember  = "member"
+	RoleAdmin   = "admin"
+	RoleManager = "manager"
+	RoleOwner   = "owner"
+)
+
+// Roles lists the organization roles, from least to most privileged.
+var Roles = []string{RoleMember, RoleAdmin, RoleManager, RoleOwner}
+
+// Member is a user's membership in an organization. Pending invitations
+// are members without a User.
+type Member struct {
+	ID          ID       `json:"id"`
+	Email       string   `json:"email"`
+	Name        string   `json:"name"`
+	Role        string   `json:"role"`
+	RoleName    string   `json:"roleName"`
+	DateCreated string   `json:"dateCreated"`
+	Pending     bool     `json:"pending"`
+	IsOwner     bool     `json:"isOwner"`
+	Teams       []string `json:"teams"`
+	User        *User    `json:"user"`
+}
+
+// IterMembers pages through the members of an organization.
+func (c *Client) IterMembers(ctx context.Context, orgSlug string, opts *ListOptions) *Iterator[Member] {
+	return newIterator[Member](ctx, c, organizationPath(orgSlug)+"members/", nil, opts)
+}
+
+// ListMembers returns the members of an organization.
+func (c *Client) ListMembers(ctx context.Context, orgSlug string, opts *ListOptions) ([]Member, error) {
+	return c.IterMembers(ctx, orgSlug, opts).All()
+}
+
+// GetMember returns a single member of an organization by member ID.
+func (c *Client) GetMember(ctx context.Context, orgSlug, memberID string) (*Member, error) {
+	var member Member
+	if err := c.get(ctx, memberPath(orgSlug, memberID), nil, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// FindMember looks a member up by member ID or by email address. The
+// error wraps ErrNotFound when no member matches.
+func (c *Client) FindMember(ctx context.Context, orgSlug, idOrEmail string) (*Member, error) {
+	it := c.IterMembers(ctx, orgSlug, nil)
+	for it.Next() {
+		member := it.Item()
+		if member.ID.String() == idOrEmail || strings.EqualFold(member.Email, idOrEmail) {
+			return &member, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("no member %q in organization %q: %w", idOrEmail, orgSlug, ErrNotFound)
+}
+
+// TeamRole adds a member to a team.
+type TeamRole struct {
+	TeamSlug string `json:"teamSlug"`
+	Role     string `json:"role,omitempty"`
+}
+
+// MemberInviteRequest is the payload for inviting a user to an
+// organization.
+type MemberInviteRequest struct {
+	Email     string     `json:"email"`
+	OrgRole   string     `json:"orgRole"`
+	TeamRoles []TeamRole `json:"teamRoles"`
+	// SendInvite sends the invitation email.
+	SendInvite bool `json:"sendInvite"`
+	// Reinvite sends the email again if the user was invited before.
+	Reinvite bool `json:"reinvite"`
+}
+
+// MemberUpdateRequest is the payload for changing a member's role and
+// teams.
+type MemberUpdateRequest struct {
+	OrgRole   string     `json:"orgRole"`
+	TeamRoles []TeamRole `json:"teamRoles"`
+}
+
+// InviteMember invites a user to an organization. The member stays
+// pending until the invitation is accepted.
+func (c *Client) InviteMember(ctx context.Context, orgSlug string, payload MemberInviteRequest) (*Member, error) {
+	var member Member
+	if err := c.post(ctx, organizationPath(orgSlug)+"members/", payload, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// UpdateMember changes the role and teams of a member.
+func (c *Client) UpdateMember(ctx context.Context, orgSlug, memberID string, payload MemberUpdateRequest) (*Member, error) {
+	var member Member
+	if err := c.put(ctx, memberPath(orgSlug, memberID), payload, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// ResendInvite sends the invitation email of a pending member again.
+func (c *Client) ResendInvite(ctx context.Context, orgSlug, memberID string) error {
+	payload := struct {
+		Reinvite bool `json:"reinvite"`
+	}{true}
+	return c.put(ctx, memberPath(orgSlug, memberID), payload, nil)
+}
+
+// DeleteMember removes a member from an organization, or withdraws a
+// pending invitation.
+func (c *Client) DeleteMember(ctx context.Context, orgSlug, memberID string) error {
+	return c.delete(ctx, memberPath(orgSlug, memberID))
+}
+
+// memberPath is the detail endpoint of an organization member.
+func memberPath(orgSlug, memberID string) string {
+	return organizationPath(orgSlug) + "members/" + pathEscape(memberID) + "/"
+}
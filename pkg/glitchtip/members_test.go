@@ -0,0 +1,104 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestFindMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":7,"email":"Ann@Example.com"},{"id":8,"email":"bob@example.com"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+
+	member, err := client.FindMember(ctx, "acme", "ann@example.com")
+	if err != nil || member.ID != "7" {
+		t.Errorf("FindMember by email = %+v, %v", member, err)
+	}
+	member, err = client.FindMember(ctx, "acme", "8")
+	if err != nil || member.Email != "bob@example.com" {
+		t.Errorf("FindMember by ID = %+v, %v", member, err)
+	}
+	if _, err := client.FindMember(ctx, "acme", "eve@example.com"); !IsNotFound(err) {
+		t.Errorf("FindMember of an unknown member = %v, want a not found error", err)
+	}
+}
+
+func TestDeleteEndpoints(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	for _, del := range []func() error{
+		func() error { return client.DeleteOrganization(ctx, "acme") },
+		func() error { return client.DeleteTeam(ctx, "acme", "ops") },
+		func() error { return client.DeleteProject(ctx, "acme", "web") },
+		func() error { return client.DeleteMember(ctx, "acme", "7") },
+	} {
+		if err := del(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{
+		"DELETE /api/0/organizations/acme/",
+		"DELETE /api/0/teams/acme/ops/",
+		"DELETE /api/0/projects/acme/web/",
+		"DELETE /api/0/organizations/acme/members/7/",
+	}
+	for i := range want {
+		if i >= len(requests) || requests[i] != want[i] {
+			t.Errorf("requests = %v, want %v", requests, want)
+			break
+		}
+	}
+}
+
+func TestInviteMember(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(data))
+		w.Write([]byte(`{"id":9,"email":"eve@example.com","role":"admin","pending":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	member, err := client.InviteMember(ctx, "acme", MemberInviteRequest{
+		Email:      "eve@example.com",
+		OrgRole:    RoleAdmin,
+		TeamRoles:  []TeamRole{{TeamSlug: "backend"}},
+		SendInvite: true,
+	})
+	if err != nil || !member.Pending {
+		t.Fatalf("InviteMember = %+v, %v", member, err)
+	}
+	if _, err := client.UpdateMember(ctx, "acme", "9", MemberUpdateRequest{OrgRole: RoleOwner, TeamRoles: []TeamRole{}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ResendInvite(ctx, "acme", "9"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`POST /api/0/organizations/acme/members/ {"email":"eve@example.com","orgRole":"admin","teamRoles":[{"teamSlug":"backend"}],"sendInvite":true,"reinvite":false}`,
+		`PUT /api/0/organizations/acme/members/9/ {"orgRole":"owner","teamRoles":[]}`,
+		`PUT /api/0/organizations/acme/members/9/ {"reinvite":true}`,
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
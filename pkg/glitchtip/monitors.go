@@ -0,0 +1,273 @@
+package glitchtip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Monitor types. Keyword monitors are GET monitors with an ExpectedBody.
+const (
+	MonitorTypePing      = "Ping"
+	MonitorTypeGET       = "GET"
+	MonitorTypePOST      = "POST"
+	MonitorTypeSSL       = "SSL"
+	MonitorTypeHeartbeat = "Heartbeat"
+)
+
+// Monitor is an uptime monitor. HTTP, ping and SSL monitors check URL
+// every Interval seconds; heartbeat monitors expect a request to
+// HeartbeatEndpoint at least that often.
+type Monitor struct {
+	ID          ID     `json:"id"`
+	Name        string `json:"name"`
+	MonitorType string `json:"monitorType"`
+	URL         string `json:"url"`
+	// ExpectedStatus is the HTTP status of a successful check, nil for
+	// any 2xx status
+	ExpectedStatus *int `json:"expectedStatus"`
+	// ExpectedBody is a keyword the response must contain
+	ExpectedBody string `json:"expectedBody"`
+	// Interval and Timeout are in seconds
+	Interval          int    `json:"interval"`
+	Timeout           *int   `json:"timeout"`
+	Project           ID     `json:"project"`
+	ProjectName       string `json:"projectName"`
+	Environment       ID     `json:"environment"`
+	IsUp              *bool  `json:"isUp"`
+	IsPaused          bool   `json:"isPaused"`
+	LastChange        string `json:"lastChange"`
+	EndpointID        string `json:"endpointId"`
+	HeartbeatEndpoint string `json:"heartbeatEndpoint"`
+	Created           string `json:"created"`
+}
+
+// MonitorRequest is the payload for creating or updating a monitor.
+// Updates replace the monitor, so start from Monitor.Request to keep the
+// fields that do not change.
+type MonitorRequest struct {
+	Name           string `json:"name"`
+	MonitorType    string `json:"monitorType"`
+	URL            string `json:"url,omitempty"`
+	ExpectedStatus *int   `json:"expectedStatus"`
+	ExpectedBody   string `json:"expectedBody"`
+	Interval       int    `json:"interval"`
+	Timeout        *int   `json:"timeout,omitempty"`
+	Project        ID     `json:"project,omitempty"`
+	Environment    ID     `json:"environment,omitempty"`
+	IsPaused       bool   `json:"isPaused"`
+}
+
+// Request returns the payload that recreates the monitor as it is.
+func (m Monitor) Request() MonitorRequest {
+	return MonitorRequest{
+		Name:           m.Name,
+		MonitorType:    m.MonitorType,
+		URL:            m.URL,
+		ExpectedStatus: m.ExpectedStatus,
+		ExpectedBody:   m.ExpectedBody,
+		Interval:       m.Interval,
+		Timeout:        m.Timeout,
+		Project:        m.Project,
+		Environment:    m.Environment,
+		IsPaused:       m.IsPaused,
+	}
+}
+
+// MonitorCheck is the result of one check of a monitor.
+type MonitorCheck struct {
+	IsUp         bool         `json:"isUp"`
+	StartCheck   string       `json:"startCheck"`
+	Reason       CheckReason  `json:"reason"`
+	ResponseTime ResponseTime `json:"responseTime"`
+}
+
+// CheckReason explains why a check failed. It is empty for successful
+// checks.
+type CheckReason string
+
+// checkReasons are the labels of the numeric reasons sent by the server.
+var checkReasons = []string{"unknown", "timeout", "wrong status code", "expected response not found", "SSL error", "network error"}
+
+// UnmarshalJSON decodes a reason given as a number or as text.
+func (r *CheckReason) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*r = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*r = CheckReason(s)
+		return nil
+	}
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*r = CheckReason(checkReasons[0])
+	if n >= 0 && n < len(checkReasons) {
+		*r = CheckReason(checkReasons[n])
+	}
+	return nil
+}
+
+// ResponseTime is how long a check took. Zero means no response.
+type ResponseTime time.Duration
+
+// UnmarshalJSON decodes milliseconds, or a duration such as
+// "00:00:00.250000".
+func (t *ResponseTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		d, err := parseDuration(s)
+		if err != nil {
+			return err
+		}
+		*t = ResponseTime(d)
+		return nil
+	}
+	var ms float64
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return err
+	}
+	*t = ResponseTime(ms * float64(time.Millisecond))
+	return nil
+}
+
+// MarshalJSON encodes the response time in milliseconds.
+func (t ResponseTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(t).Milliseconds())
+}
+
+// parseDuration parses the "[DD ]HH:MM:SS[.ffffff]" form the server
+// uses for durations.
+func parseDuration(s string) (time.Duration, error) {
+	var days time.Duration
+	if before, after, ok := strings.Cut(s, " "); ok {
+		n, err := strconv.Atoi(before)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		days, s = time.Duration(n)*24*time.Hour, after
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	hours, err1 := strconv.Atoi(parts[0])
+	minutes, err2 := strconv.Atoi(parts[1])
+	seconds, err3 := strconv.ParseFloat(parts[2], 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return days + time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), nil
+}
+
+// Uptime returns the percentage of successful checks, or -1 when there
+// are none.
+func Uptime(checks []MonitorCheck) float64 {
+	if len(checks) == 0 {
+		return -1
+	}
+	up := 0
+	for _, check := range checks {
+		if check.IsUp {
+			up++
+		}
+	}
+	return float64(up) * 100 / float64(len(checks))
+}
+
+// ListMonitors returns the uptime monitors of an organization.
+func (c *Client) ListMonitors(ctx context.Context, orgSlug string) ([]Monitor, error) {
+	return newIterator[Monitor](ctx, c, monitorsPath(orgSlug), nil, nil).All()
+}
+
+// GetMonitor returns a single monitor by ID.
+func (c *Client) GetMonitor(ctx context.Context, orgSlug, monitorID string) (*Monitor, error) {
+	var monitor Monitor
+	if err := c.get(ctx, monitorPath(orgSlug, monitorID), nil, &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// FindMonitor looks a monitor up by ID or by name. The error wraps
+// ErrNotFound when no monitor matches.
+func (c *Client) FindMonitor(ctx context.Context, orgSlug, idOrName string) (*Monitor, error) {
+	monitors, err := c.ListMonitors(ctx, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+	for _, monitor := range monitors {
+		if monitor.ID.String() == idOrName {
+			return &monitor, nil
+		}
+	}
+	var found *Monitor
+	for i, monitor := range monitors {
+		if strings.EqualFold(monitor.Name, idOrName) {
+			if found != nil {
+				return nil, fmt.Errorf("organization %q has several monitors named %q, use the monitor ID instead", orgSlug, idOrName)
+			}
+			found = &monitors[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no monitor %q in organization %q: %w", idOrName, orgSlug, ErrNotFound)
+	}
+	return found, nil
+}
+
+// CreateMonitor creates an uptime monitor.
+func (c *Client) CreateMonitor(ctx context.Context, orgSlug string, payload MonitorRequest) (*Monitor, error) {
+	var monitor Monitor
+	if err := c.post(ctx, monitorsPath(orgSlug), payload, &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// UpdateMonitor replaces the settings of a monitor.
+func (c *Client) UpdateMonitor(ctx context.Context, orgSlug, monitorID string, payload MonitorRequest) (*Monitor, error) {
+	var monitor Monitor
+	if err := c.put(ctx, monitorPath(orgSlug, monitorID), payload, &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// DeleteMonitor deletes a monitor with its check history.
+func (c *Client) DeleteMonitor(ctx context.Context, orgSlug, monitorID string) error {
+	return c.delete(ctx, monitorPath(orgSlug, monitorID))
+}
+
+// ListMonitorChecks returns the recent checks of a monitor, newest first.
+func (c *Client) ListMonitorChecks(ctx context.Context, orgSlug, monitorID string, opts *ListOptions) ([]MonitorCheck, error) {
+	return newIterator[MonitorCheck](ctx, c, monitorPath(orgSlug, monitorID)+"checks/", nil, opts).All()
+}
+
+// monitorsPath is the monitor list endpoint of an organization.
+func monitorsPath(orgSlug string) string {
+	return organizationPath(orgSlug) + "monitors/"
+}
+
+// monitorPath is the detail endpoint of a monitor.
+func monitorPath(orgSlug, monitorID string) string {
+	return monitorsPath(orgSlug) + pathEscape(monitorID) + "/"
+}
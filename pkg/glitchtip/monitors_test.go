@@ -0,0 +1,84 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListMonitorChecks(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`[
+			{"isUp":true,"startCheck":"2024-05-14T10:02:00Z","reason":null,"responseTime":"00:00:00.250000"},
+			{"isUp":false,"startCheck":"2024-05-14T10:01:00Z","reason":2,"responseTime":1500},
+			{"isUp":false,"startCheck":"2024-05-14T10:00:00Z","reason":"Timeout","responseTime":null},
+			{"isUp":true,"startCheck":"2024-05-14T09:59:00Z","reason":9,"responseTime":"1 00:00:01"}
+		]`))
+	}))
+	defer server.Close()
+
+	checks, err := NewClient(server.URL, "token").ListMonitorChecks(context.Background(), "acme", "5", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/api/0/organizations/acme/monitors/5/checks/" {
+		t.Errorf("path = %s", path)
+	}
+
+	wantReasons := []CheckReason{"", "wrong status code", "Timeout", "unknown"}
+	wantTimes := []time.Duration{250 * time.Millisecond, 1500 * time.Millisecond, 0, 24*time.Hour + time.Second}
+	for i, check := range checks {
+		if check.Reason != wantReasons[i] || time.Duration(check.ResponseTime) != wantTimes[i] {
+			t.Errorf("check %d = %+v, want reason %q and response time %s", i, check, wantReasons[i], wantTimes[i])
+		}
+	}
+	if uptime := Uptime(checks); uptime != 50 {
+		t.Errorf("Uptime = %v, want 50", uptime)
+	}
+	if uptime := Uptime(nil); uptime != -1 {
+		t.Errorf("Uptime of no checks = %v, want -1", uptime)
+	}
+}
+
+func TestUpdateMonitor(t *testing.T) {
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[{"id":5,"name":"API","monitorType":"GET","url":"https://example.com","expectedStatus":200,"expectedBody":"","interval":60,"timeout":null,"project":3,"environment":null,"isPaused":false}]`))
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	monitor, err := client.FindMonitor(ctx, "acme", "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.FindMonitor(ctx, "acme", "web"); !IsNotFound(err) {
+		t.Errorf("FindMonitor of an unknown monitor = %v, want a not found error", err)
+	}
+
+	payload := monitor.Request()
+	payload.IsPaused = true
+	if _, err := client.UpdateMonitor(ctx, "acme", monitor.ID.String(), payload); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut || path != "/api/0/organizations/acme/monitors/5/" {
+		t.Errorf("request = %s %s", method, path)
+	}
+	want := `{"name":"API","monitorType":"GET","url":"https://example.com","expectedStatus":200,"expectedBody":"","interval":60,"project":"3","isPaused":true}`
+	if body != want {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+}
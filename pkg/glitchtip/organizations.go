@@ -0,0 +1,98 @@
+package glitchtip
+
+import (
+	"context"
+	"strings"
+)
+
+// Organization represents the structure of your organization data
+type Organization struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Slug              string `json:"slug"`
+	DateCreated       string `json:"dateCreated"`
+	Status            Status `json:"status"`
+	Avatar            Avatar `json:"avatar"`
+	IsEarlyAdopter    bool   `json:"isEarlyAdopter"`
+	Require2FA        bool   `json:"require2FA"`
+	OpenMembership    bool   `json:"openMembership"`
+	IsAcceptingEvents bool   `json:"isAcceptingEvents"`
+}
+
+// Status represents the nested status structure
+type Status struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// OrganizationCreateRequest is the payload for creating an organization.
+type OrganizationCreateRequest struct {
+	Name string `json:"name"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// OrganizationUpdateRequest is the payload for updating an organization.
+// Only the fields that are set are changed.
+type OrganizationUpdateRequest struct {
+	Name              *string `json:"name,omitempty"`
+	Require2FA        *bool   `json:"require2FA,omitempty"`
+	OpenMembership    *bool   `json:"openMembership,omitempty"`
+	IsAcceptingEvents *bool   `json:"isAcceptingEvents,omitempty"`
+}
+
+// IterOrganizations pages through the organizations the token has access to.
+func (c *Client) IterOrganizations(ctx context.Context, opts *ListOptions) *Iterator[Organization] {
+	return newIterator[Organization](ctx, c, "organizations/", nil, opts)
+}
+
+// ListOrganizations returns the organizations the token has access to.
+func (c *Client) ListOrganizations(ctx context.Context, opts *ListOptions) ([]Organization, error) {
+	return c.IterOrganizations(ctx, opts).All()
+}
+
+// GetOrganization returns a single organization by slug.
+func (c *Client) GetOrganization(ctx context.Context, orgSlug string) (*Organization, error) {
+	var organization Organization
+	if err := c.get(ctx, organizationPath(orgSlug), nil, &organization); err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// CreateOrganization creates a new organization. When no slug is given,
+// one is derived from the name.
+func (c *Client) CreateOrganization(ctx context.Context, payload OrganizationCreateRequest) (*Organization, error) {
+	if payload.Slug == "" {
+		payload.Slug = Slugify(payload.Name)
+	}
+	var organization Organization
+	if err := c.post(ctx, "organizations/", payload, &organization); err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// UpdateOrganization changes the settings of an organization.
+func (c *Client) UpdateOrganization(ctx context.Context, orgSlug string, payload OrganizationUpdateRequest) (*Organization, error) {
+	var organization Organization
+	if err := c.put(ctx, organizationPath(orgSlug), payload, &organization); err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// DeleteOrganization deletes an organization with all of its teams,
+// projects and events.
+func (c *Client) DeleteOrganization(ctx context.Context, orgSlug string) error {
+	return c.delete(ctx, organizationPath(orgSlug))
+}
+
+// Slugify generates a slug from a display name (simple version)
+func Slugify(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "-"))
+}
+
+// organizationPath is the detail endpoint of an organization.
+func organizationPath(orgSlug string) string {
+	return "organizations/" + pathEscape(orgSlug) + "/"
+}
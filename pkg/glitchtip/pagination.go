@@ -0,0 +1,202 @@
+package glitchtip
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListOptions controls how list endpoints are paged.
+type ListOptions struct {
+	// Limit caps the total number of items returned. Zero means no limit.
+	Limit int
+	// PageSize is the number of items requested per page. Zero uses the
+	// server default.
+	PageSize int
+}
+
+// Iterator walks a paginated list endpoint one item at a time, fetching
+// the next page only when the current one is exhausted, so memory stays
+// bounded by the page size:
+//
+//	it := client.IterProjects(ctx, nil)
+//	for it.Next() {
+//		project := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx    context.Context
+	client *Client
+	path   string
+	query  url.Values
+	limit  int
+
+	page  []T
+	index int
+	item  T
+	count int
+	done  bool
+	err   error
+}
+
+// newIterator creates an iterator for the list endpoint at path.
+func newIterator[T any](ctx context.Context, c *Client, path string, query url.Values, opts *ListOptions) *Iterator[T] {
+	if query == nil {
+		query = url.Values{}
+	}
+	it := &Iterator[T]{ctx: ctx, client: c, path: path, query: query}
+	if opts != nil {
+		it.limit = opts.Limit
+		// Never ask for more items per page than the limit allows.
+		pageSize := opts.PageSize
+		if opts.Limit > 0 && (pageSize == 0 || opts.Limit < pageSize) {
+			pageSize = opts.Limit
+		}
+		if pageSize > 0 {
+			query.Set("limit", strconv.Itoa(pageSize))
+		}
+	}
+	return it
+}
+
+// Next advances to the next item, fetching another page when needed. It
+// returns false when the list is exhausted, the limit is reached or an
+// error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil || (it.limit > 0 && it.count >= it.limit) {
+		return false
+	}
+	for it.index >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.item = it.page[it.index]
+	it.index++
+	it.count++
+	return true
+}
+
+// Item returns the current item.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All drains the iterator into a slice.
+func (it *Iterator[T]) All() ([]T, error) {
+	items := []T{}
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// fetch loads the next page and records the cursor of the one after it.
+func (it *Iterator[T]) fetch() bool {
+	req, err := it.client.newRequest(it.ctx, http.MethodGet, it.path, it.query, nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	var page []T
+	resp, err := it.client.do(req, &page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = page
+	it.index = 0
+
+	cursor, ok := nextCursor(resp.Header.Values("Link"))
+	if !ok || len(page) == 0 {
+		it.done = true
+	} else {
+		it.query.Set("cursor", cursor)
+	}
+	return true
+}
+
+// nextCursor extracts the cursor of the rel="next" link from Link headers
+// of the form:
+//
+//	<https://host/api/0/projects/?cursor=abc>; rel="next"; results="true"; cursor="abc"
+//
+// It reports false when there is no next page.
+func nextCursor(headers []string) (string, bool) {
+	for _, header := range headers {
+		for _, link := range splitLinks(header) {
+			target, params, ok := parseLink(link)
+			if !ok || params["rel"] != "next" {
+				continue
+			}
+			if results, ok := params["results"]; ok && results != "true" {
+				return "", false
+			}
+			if cursor := params["cursor"]; cursor != "" {
+				return cursor, true
+			}
+			if u, err := url.Parse(target); err == nil {
+				if cursor := u.Query().Get("cursor"); cursor != "" {
+					return cursor, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// splitLinks splits a Link header into its comma separated links, ignoring
+// commas inside the angle-bracketed URLs.
+func splitLinks(header string) []string {
+	var links []string
+	depth := 0
+	start := 0
+	for i, r := range header {
+		switch r {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				links = append(links, header[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(links, header[start:])
+}
+
+// parseLink splits one link into its URL and parameters.
+func parseLink(link string) (string, map[string]string, bool) {
+	parts := strings.Split(link, ";")
+	target := strings.TrimSpace(parts[0])
+	if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+		return "", nil, false
+	}
+
+	params := map[string]string{}
+	for _, part := range parts[1:] {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		params[strings.ToLower(key)] = strings.Trim(value, `"`)
+	}
+	return strings.Trim(target, "<>"), params, true
+}
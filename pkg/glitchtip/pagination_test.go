@@ -0,0 +1,178 @@
+package glitchtip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// pagedServer serves total organizations from /api/0/organizations/ using
+// numeric offsets as cursors. failAt makes the request for that offset
+// fail; a negative value disables it. Requested page sizes are recorded.
+func pagedServer(t *testing.T, total, defaultSize, failAt int, pageSizes *[]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if pageSizes != nil {
+			*pageSizes = append(*pageSizes, query.Get("limit"))
+		}
+		size := defaultSize
+		if limit := query.Get("limit"); limit != "" {
+			size, _ = strconv.Atoi(limit)
+		}
+		start, _ := strconv.Atoi(query.Get("cursor"))
+		if start == failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"detail":"boom"}`)
+			return
+		}
+
+		var page []Organization
+		for i := start; i < start+size && i < total; i++ {
+			page = append(page, Organization{ID: i, Slug: fmt.Sprintf("org%d", i)})
+		}
+		next := start + size
+		results := "false"
+		if next < total {
+			results = "true"
+		}
+		w.Header().Set("Link", fmt.Sprintf(
+			`<http://example.com/api/0/organizations/?cursor=0>; rel="previous"; results="false"; cursor="0", `+
+				`<http://example.com/api/0/organizations/?cursor=%d>; rel="next"; results="%s"; cursor="%d"`,
+			next, results, next))
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestListOrganizationsFollowsNextCursor(t *testing.T) {
+	server := pagedServer(t, 7, 3, -1, nil)
+	client := NewClient(server.URL, "token")
+
+	orgs, err := client.ListOrganizations(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListOrganizations: %v", err)
+	}
+	if len(orgs) != 7 {
+		t.Fatalf("got %d organizations, want 7", len(orgs))
+	}
+	for i, org := range orgs {
+		if org.ID != i {
+			t.Errorf("orgs[%d].ID = %d, want %d", i, org.ID, i)
+		}
+	}
+}
+
+func TestIteratorStopsWhenNextHasNoResults(t *testing.T) {
+	var pageSizes []string
+	server := pagedServer(t, 3, 3, -1, &pageSizes)
+	client := NewClient(server.URL, "token")
+
+	orgs, err := client.ListOrganizations(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListOrganizations: %v", err)
+	}
+	if len(orgs) != 3 {
+		t.Fatalf("got %d organizations, want 3", len(orgs))
+	}
+	if len(pageSizes) != 1 {
+		t.Errorf("made %d requests, want 1", len(pageSizes))
+	}
+}
+
+func TestIteratorLimitStopsMidPage(t *testing.T) {
+	var pageSizes []string
+	server := pagedServer(t, 10, 3, -1, &pageSizes)
+	client := NewClient(server.URL, "token")
+
+	orgs, err := client.ListOrganizations(context.Background(), &ListOptions{Limit: 5, PageSize: 3})
+	if err != nil {
+		t.Fatalf("ListOrganizations: %v", err)
+	}
+	if len(orgs) != 5 {
+		t.Fatalf("got %d organizations, want 5", len(orgs))
+	}
+	if len(pageSizes) != 2 {
+		t.Errorf("made %d requests, want 2", len(pageSizes))
+	}
+}
+
+func TestIteratorPageSizeCappedByLimit(t *testing.T) {
+	var pageSizes []string
+	server := pagedServer(t, 10, 5, -1, &pageSizes)
+	client := NewClient(server.URL, "token")
+
+	if _, err := client.ListOrganizations(context.Background(), &ListOptions{Limit: 1}); err != nil {
+		t.Fatalf("ListOrganizations: %v", err)
+	}
+	if len(pageSizes) != 1 || pageSizes[0] != "1" {
+		t.Errorf("requested page sizes %v, want [1]", pageSizes)
+	}
+}
+
+func TestIteratorErrorPartwayThrough(t *testing.T) {
+	server := pagedServer(t, 10, 3, 6, nil)
+	client := NewClient(server.URL, "token")
+
+	it := client.IterOrganizations(context.Background(), nil)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 6 {
+		t.Errorf("iterated %d items before the error, want 6", count)
+	}
+	if it.Err() == nil {
+		t.Fatal("expected an error from the failing page")
+	}
+	if it.Next() {
+		t.Error("Next returned true after an error")
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		cursor string
+		ok     bool
+	}{
+		{
+			name:   "cursor parameter",
+			header: `<http://x/api/0/p/?cursor=a>; rel="previous"; results="false"; cursor="a", <http://x/api/0/p/?cursor=b>; rel="next"; results="true"; cursor="b"`,
+			cursor: "b",
+			ok:     true,
+		},
+		{
+			name:   "no more results",
+			header: `<http://x/api/0/p/?cursor=b>; rel="next"; results="false"; cursor="b"`,
+		},
+		{
+			name:   "cursor only in url with comma in query",
+			header: `<http://x/api/0/p/?a=1,2&cursor=c>; rel="next"`,
+			cursor: "c",
+			ok:     true,
+		},
+		{
+			name:   "no next link",
+			header: `<http://x/api/0/p/?cursor=a>; rel="previous"; results="true"; cursor="a"`,
+		},
+		{
+			name:   "malformed",
+			header: `garbage`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, ok := nextCursor([]string{tt.header})
+			if cursor != tt.cursor || ok != tt.ok {
+				t.Errorf("nextCursor() = %q, %t; want %q, %t", cursor, ok, tt.cursor, tt.ok)
+			}
+		})
+	}
+}
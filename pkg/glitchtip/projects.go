@@ -0,0 +1,107 @@
+package glitchtip
+
+import "context"
+
+// Project is an application that sends events to GlitchTip.
+type Project struct {
+	ID                ID                   `json:"id"`
+	Name              string               `json:"name"`
+	Slug              string               `json:"slug"`
+	Platform          string               `json:"platform"`
+	DateCreated       string               `json:"dateCreated"`
+	FirstEvent        string               `json:"firstEvent"`
+	IsMember          bool                 `json:"isMember"`
+	HasAccess         bool                 `json:"hasAccess"`
+	IsBookmarked      bool                 `json:"isBookmarked"`
+	ScrubIPAddresses  bool                 `json:"scrubIPAddresses"`
+	EventThrottleRate int                  `json:"eventThrottleRate"`
+	Organization      *ProjectOrganization `json:"organization,omitempty"`
+	Teams             []ProjectTeam        `json:"teams,omitempty"`
+}
+
+// ProjectOrganization is the abbreviated organization nested in a project.
+type ProjectOrganization struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// ProjectTeam is the abbreviated team nested in a project.
+type ProjectTeam struct {
+	ID   ID     `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// ProjectCreateRequest is the payload for creating a project.
+type ProjectCreateRequest struct {
+	Name     string `json:"name"`
+	Slug     string `json:"slug,omitempty"`
+	Platform string `json:"platform,omitempty"`
+}
+
+// ProjectUpdateRequest is the payload for updating a project. Only the
+// fields that are set are changed.
+type ProjectUpdateRequest struct {
+	Name              *string `json:"name,omitempty"`
+	Slug              *string `json:"slug,omitempty"`
+	Platform          *string `json:"platform,omitempty"`
+	EventThrottleRate *int    `json:"eventThrottleRate,omitempty"`
+	ScrubIPAddresses  *bool   `json:"scrubIPAddresses,omitempty"`
+}
+
+// IterProjects pages through every project the token has access to, across organizations.
+func (c *Client) IterProjects(ctx context.Context, opts *ListOptions) *Iterator[Project] {
+	return newIterator[Project](ctx, c, "projects/", nil, opts)
+}
+
+// ListProjects returns every project the token has access to, across organizations.
+func (c *Client) ListProjects(ctx context.Context, opts *ListOptions) ([]Project, error) {
+	return c.IterProjects(ctx, opts).All()
+}
+
+// IterOrganizationProjects pages through the projects of one organization.
+func (c *Client) IterOrganizationProjects(ctx context.Context, orgSlug string, opts *ListOptions) *Iterator[Project] {
+	return newIterator[Project](ctx, c, organizationPath(orgSlug)+"projects/", nil, opts)
+}
+
+// ListOrganizationProjects returns the projects of one organization.
+func (c *Client) ListOrganizationProjects(ctx context.Context, orgSlug string, opts *ListOptions) ([]Project, error) {
+	return c.IterOrganizationProjects(ctx, orgSlug, opts).All()
+}
+
+// GetProject returns a single project by organization and project slug.
+func (c *Client) GetProject(ctx context.Context, orgSlug, projectSlug string) (*Project, error) {
+	var project Project
+	if err := c.get(ctx, projectPath(orgSlug, projectSlug), nil, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// CreateProject creates a project owned by a team.
+func (c *Client) CreateProject(ctx context.Context, orgSlug, teamSlug string, payload ProjectCreateRequest) (*Project, error) {
+	var project Project
+	if err := c.post(ctx, teamPath(orgSlug, teamSlug)+"projects/", payload, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// UpdateProject changes the settings of a project.
+func (c *Client) UpdateProject(ctx context.Context, orgSlug, projectSlug string, payload ProjectUpdateRequest) (*Project, error) {
+	var project Project
+	if err := c.put(ctx, projectPath(orgSlug, projectSlug), payload, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// DeleteProject deletes a project with all of its issues and events.
+func (c *Client) DeleteProject(ctx context.Context, orgSlug, projectSlug string) error {
+	return c.delete(ctx, projectPath(orgSlug, projectSlug))
+}
+
+// projectPath is the detail endpoint of a project.
+func projectPath(orgSlug, projectSlug string) string {
+	return "projects/" + pathEscape(orgSlug) + "/" + pathEscape(projectSlug) + "/"
+}
@@ -0,0 +1,86 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProjectRelatedLists(t *testing.T) {
+	responses := map[string]string{
+		"/api/0/projects/acme/web/keys/":         `[{"id":"k1","name":"Default","isActive":true,"dsn":{"public":"https://k1@example.com/3"}}]`,
+		"/api/0/projects/acme/web/environments/": `[{"id":1,"name":"production","isHidden":false}]`,
+		"/api/0/projects/acme/web/alerts/":       `[{"id":2,"timespanMinutes":5,"quantity":10,"alertRecipients":[{"recipientType":"webhook","url":"https://hooks.example.com"}]}]`,
+		"/api/0/teams/acme/ops/members/":         `[{"id":7,"email":"ann@example.com"}]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+
+	keys, err := client.ListProjectKeys(ctx, "acme", "web")
+	if err != nil || len(keys) != 1 || keys[0].DSN.Public != "https://k1@example.com/3" || !keys[0].IsActive {
+		t.Errorf("ListProjectKeys = %+v, %v", keys, err)
+	}
+	environments, err := client.ListProjectEnvironments(ctx, "acme", "web")
+	if err != nil || len(environments) != 1 || environments[0].Name != "production" {
+		t.Errorf("ListProjectEnvironments = %+v, %v", environments, err)
+	}
+	alerts, err := client.ListProjectAlerts(ctx, "acme", "web")
+	if err != nil || len(alerts) != 1 || alerts[0].Quantity != 10 || alerts[0].AlertRecipients[0].URL != "https://hooks.example.com" {
+		t.Errorf("ListProjectAlerts = %+v, %v", alerts, err)
+	}
+	members, err := client.ListTeamMembers(ctx, "acme", "ops")
+	if err != nil || len(members) != 1 || members[0].Email != "ann@example.com" {
+		t.Errorf("ListTeamMembers = %+v, %v", members, err)
+	}
+}
+
+func TestUpdateEndpoints(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"slug":"renamed"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	name, off, rate := "Acme Inc", false, 0
+
+	org, err := client.UpdateOrganization(ctx, "acme", OrganizationUpdateRequest{Name: &name, OpenMembership: &off})
+	if err != nil || org.Slug != "renamed" {
+		t.Errorf("UpdateOrganization = %+v, %v", org, err)
+	}
+	if _, err := client.UpdateTeam(ctx, "acme", "ops", TeamUpdateRequest{Slug: "sre"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UpdateProject(ctx, "acme", "web", ProjectUpdateRequest{EventThrottleRate: &rate, ScrubIPAddresses: &off}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unset fields are left out, while false and zero values are sent
+	want := []string{
+		`PUT /api/0/organizations/acme/ {"name":"Acme Inc","openMembership":false}`,
+		`PUT /api/0/teams/acme/ops/ {"slug":"sre"}`,
+		`PUT /api/0/projects/acme/web/ {"eventThrottleRate":0,"scrubIPAddresses":false}`,
+	}
+	for i := range want {
+		if i >= len(requests) || strings.TrimSpace(requests[i]) != want[i] {
+			t.Errorf("requests = %q, want %q", requests, want)
+			break
+		}
+	}
+}
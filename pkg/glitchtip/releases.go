@@ -0,0 +1,170 @@
+package glitchtip
+
+import (
+	"context"
+	"time"
+)
+
+// Release is a version of the code deployed to one or more projects of
+// an organization.
+type Release struct {
+	Version      string           `json:"version"`
+	ShortVersion string           `json:"shortVersion"`
+	Ref          string           `json:"ref"`
+	URL          string           `json:"url"`
+	DateCreated  string           `json:"dateCreated"`
+	DateReleased string           `json:"dateReleased"`
+	CommitCount  int              `json:"commitCount"`
+	DeployCount  int              `json:"deployCount"`
+	NewGroups    int              `json:"newGroups"`
+	Projects     []ReleaseProject `json:"projects"`
+	LastDeploy   *Deploy          `json:"lastDeploy"`
+}
+
+// ReleaseProject is the abbreviated project nested in a release.
+type ReleaseProject struct {
+	ID       ID     `json:"id"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	Platform string `json:"platform"`
+}
+
+// ReleaseCreateRequest is the payload for creating a release.
+type ReleaseCreateRequest struct {
+	Version string `json:"version"`
+	// Projects lists the slugs of the projects the release belongs to.
+	Projects     []string   `json:"projects"`
+	Ref          string     `json:"ref,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	DateReleased *time.Time `json:"dateReleased,omitempty"`
+}
+
+// ReleaseUpdate is the payload for updating a release. Empty fields are
+// left unchanged.
+type ReleaseUpdate struct {
+	Ref          string     `json:"ref,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	DateReleased *time.Time `json:"dateReleased,omitempty"`
+	// Commits and Refs associate commits with the release the way
+	// sentry-cli sends them. Servers that do not track commits keep
+	// only Ref.
+	Commits []ReleaseCommit `json:"commits,omitempty"`
+	Refs    []ReleaseRef    `json:"refs,omitempty"`
+}
+
+// ReleaseCommit is a commit that is part of a release.
+type ReleaseCommit struct {
+	ID          string `json:"id"`
+	Repository  string `json:"repository,omitempty"`
+	Message     string `json:"message,omitempty"`
+	AuthorName  string `json:"author_name,omitempty"`
+	AuthorEmail string `json:"author_email,omitempty"`
+	Timestamp   string `json:"timestamp,omitempty"`
+}
+
+// ReleaseRef names the last commit of a release in a repository, and
+// optionally the last commit of the previous release.
+type ReleaseRef struct {
+	Repository     string `json:"repository"`
+	Commit         string `json:"commit"`
+	PreviousCommit string `json:"previousCommit,omitempty"`
+}
+
+// Deploy records a release being deployed to an environment.
+type Deploy struct {
+	ID           ID     `json:"id"`
+	Environment  string `json:"environment"`
+	Name         string `json:"name"`
+	URL          string `json:"url"`
+	DateStarted  string `json:"dateStarted"`
+	DateFinished string `json:"dateFinished"`
+}
+
+// DeployCreateRequest is the payload for creating a deploy.
+type DeployCreateRequest struct {
+	Environment  string     `json:"environment"`
+	Name         string     `json:"name,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	DateStarted  *time.Time `json:"dateStarted,omitempty"`
+	DateFinished *time.Time `json:"dateFinished,omitempty"`
+}
+
+// IterReleases pages through the releases of an organization, newest
+// first.
+func (c *Client) IterReleases(ctx context.Context, orgSlug string, opts *ListOptions) *Iterator[Release] {
+	return newIterator[Release](ctx, c, releasesPath(orgSlug), nil, opts)
+}
+
+// ListReleases returns the releases of an organization, newest first.
+func (c *Client) ListReleases(ctx context.Context, orgSlug string, opts *ListOptions) ([]Release, error) {
+	return c.IterReleases(ctx, orgSlug, opts).All()
+}
+
+// IterProjectReleases pages through the releases of one project.
+func (c *Client) IterProjectReleases(ctx context.Context, orgSlug, projectSlug string, opts *ListOptions) *Iterator[Release] {
+	return newIterator[Release](ctx, c, projectPath(orgSlug, projectSlug)+"releases/", nil, opts)
+}
+
+// ListProjectReleases returns the releases of one project.
+func (c *Client) ListProjectReleases(ctx context.Context, orgSlug, projectSlug string, opts *ListOptions) ([]Release, error) {
+	return c.IterProjectReleases(ctx, orgSlug, projectSlug, opts).All()
+}
+
+// GetRelease returns a single release by version.
+func (c *Client) GetRelease(ctx context.Context, orgSlug, version string) (*Release, error) {
+	var release Release
+	if err := c.get(ctx, releasePath(orgSlug, version), nil, &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// CreateRelease creates a release. Creating a version that already
+// exists adds the projects to it.
+func (c *Client) CreateRelease(ctx context.Context, orgSlug string, payload ReleaseCreateRequest) (*Release, error) {
+	var release Release
+	if err := c.post(ctx, releasesPath(orgSlug), payload, &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// UpdateRelease changes a release, for example to finalize it by setting
+// DateReleased.
+func (c *Client) UpdateRelease(ctx context.Context, orgSlug, version string, payload ReleaseUpdate) (*Release, error) {
+	var release Release
+	if err := c.put(ctx, releasePath(orgSlug, version), payload, &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// DeleteRelease deletes a release with its files and deploys.
+func (c *Client) DeleteRelease(ctx context.Context, orgSlug, version string) error {
+	return c.delete(ctx, releasePath(orgSlug, version))
+}
+
+// ListDeploys returns the deploys of a release.
+func (c *Client) ListDeploys(ctx context.Context, orgSlug, version string) ([]Deploy, error) {
+	return newIterator[Deploy](ctx, c, releasePath(orgSlug, version)+"deploys/", nil, nil).All()
+}
+
+// CreateDeploy records a deploy of a release.
+func (c *Client) CreateDeploy(ctx context.Context, orgSlug, version string, payload DeployCreateRequest) (*Deploy, error) {
+	var deploy Deploy
+	if err := c.post(ctx, releasePath(orgSlug, version)+"deploys/", payload, &deploy); err != nil {
+		return nil, err
+	}
+	return &deploy, nil
+}
+
+// releasesPath is the release list endpoint of an organization.
+func releasesPath(orgSlug string) string {
+	return organizationPath(orgSlug) + "releases/"
+}
+
+// releasePath is the detail endpoint of a release. Versions may contain
+// characters such as "+" or "/", which are escaped.
+func releasePath(orgSlug, version string) string {
+	return releasesPath(orgSlug) + pathEscape(version) + "/"
+}
@@ -0,0 +1,45 @@
+package glitchtip
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReleaseEndpoints(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath()+" "+string(body))
+		w.Write([]byte(`{"version":"1.0.0+build/7"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	released := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	if _, err := client.CreateRelease(ctx, "acme", ReleaseCreateRequest{Version: "1.0.0+build/7", Projects: []string{"web"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UpdateRelease(ctx, "acme", "1.0.0+build/7", ReleaseUpdate{DateReleased: &released}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateDeploy(ctx, "acme", "1.0.0+build/7", DeployCreateRequest{Environment: "production"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`POST /api/0/organizations/acme/releases/ {"version":"1.0.0+build/7","projects":["web"]}`,
+		`PUT /api/0/organizations/acme/releases/1.0.0+build%2F7/ {"dateReleased":"2024-05-01T08:00:00Z"}`,
+		`POST /api/0/organizations/acme/releases/1.0.0+build%2F7/deploys/ {"environment":"production"}`,
+	}
+	for i := range want {
+		if i >= len(requests) || requests[i] != want[i] {
+			t.Errorf("requests = %q, want %q at index %d", requests, want[i], i)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package glitchtip
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// retryDelay is the wait before the first retry. It doubles with every
+// further attempt.
+var retryDelay = 500 * time.Millisecond
+
+// withRetry calls fn up to attempts times while it fails with an error
+// worth retrying: a network error, 429 Too Many Requests or a 5xx response.
+func withRetry(ctx context.Context, attempts int, fn func() error) error {
+	delay := retryDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = fn(); err == nil || attempt >= attempts || !isRetryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// isRetryable reports whether a failed request may succeed when sent
+// again.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	status := StatusCode(err)
+	if status == 0 {
+		// No response at all, e.g. a reset connection
+		return true
+	}
+	return status == http.StatusTooManyRequests || status >= 500
+}
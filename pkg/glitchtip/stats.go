@@ -0,0 +1,97 @@
+package glitchtip
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// Stats fields that can be summed by the stats endpoint.
+const (
+	// StatsFieldQuantity counts accepted events.
+	StatsFieldQuantity = "sum(quantity)"
+	// StatsFieldTimesSeen counts how often issues were seen.
+	StatsFieldTimesSeen = "sum(times_seen)"
+)
+
+// StatsQuery selects the event counts returned by EventStats.
+type StatsQuery struct {
+	// Category is "error" or "transaction". It defaults to "error".
+	Category string
+	// Field is one of the StatsField constants. It defaults to
+	// StatsFieldQuantity.
+	Field string
+	// Interval is the bucket size, e.g. "1h" or "1d".
+	Interval string
+	// ProjectIDs restricts the counts to the given projects.
+	ProjectIDs []ID
+	Start      time.Time
+	End        time.Time
+}
+
+// EventStats is a time series of event counts grouped by project.
+type EventStats struct {
+	Intervals []string          `json:"intervals"`
+	Groups    []EventStatsGroup `json:"groups"`
+}
+
+// EventStatsGroup is the series of one project.
+type EventStatsGroup struct {
+	By     map[string]interface{} `json:"by"`
+	Totals map[string]int         `json:"totals"`
+	Series map[string][]int       `json:"series"`
+}
+
+// Total sums field over every group.
+func (s *EventStats) Total(field string) int {
+	total := 0
+	for _, group := range s.Groups {
+		total += group.Totals[field]
+	}
+	return total
+}
+
+// Series sums field per interval over every group.
+func (s *EventStats) Series(field string) []int {
+	series := make([]int, len(s.Intervals))
+	for _, group := range s.Groups {
+		for i, value := range group.Series[field] {
+			if i < len(series) {
+				series[i] += value
+			}
+		}
+	}
+	return series
+}
+
+// GetEventStats returns event counts of an organization over time.
+func (c *Client) GetEventStats(ctx context.Context, orgSlug string, q StatsQuery) (*EventStats, error) {
+	if q.Category == "" {
+		q.Category = "error"
+	}
+	if q.Field == "" {
+		q.Field = StatsFieldQuantity
+	}
+
+	query := url.Values{}
+	query.Set("category", q.Category)
+	query.Set("field", q.Field)
+	if q.Interval != "" {
+		query.Set("interval", q.Interval)
+	}
+	for _, id := range q.ProjectIDs {
+		query.Add("project", id.String())
+	}
+	if !q.Start.IsZero() {
+		query.Set("start", q.Start.UTC().Format(time.RFC3339))
+	}
+	if !q.End.IsZero() {
+		query.Set("end", q.End.UTC().Format(time.RFC3339))
+	}
+
+	var stats EventStats
+	if err := c.get(ctx, organizationPath(orgSlug)+"stats_v2/", query, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
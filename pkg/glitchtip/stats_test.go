@@ -0,0 +1,73 @@
+package glitchtip
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetEventStats(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/0/organizations/acme/stats_v2/" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		query = r.URL.RawQuery
+		w.Write([]byte(`{
+			"intervals": ["2024-05-01T00:00:00Z", "2024-05-02T00:00:00Z"],
+			"groups": [
+				{"by": {"project": 3}, "totals": {"sum(quantity)": 5}, "series": {"sum(quantity)": [2, 3]}},
+				{"by": {"project": 4}, "totals": {"sum(quantity)": 1}, "series": {"sum(quantity)": [0, 1]}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	stats, err := NewClient(server.URL, "token").GetEventStats(context.Background(), "acme", StatsQuery{
+		Interval:   "1d",
+		ProjectIDs: []ID{"3", "4"},
+		Start:      start,
+		End:        start.Add(48 * time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "category=error&end=2024-05-03T00%3A00%3A00Z&field=sum%28quantity%29&interval=1d&project=3&project=4&start=2024-05-01T00%3A00%3A00Z"
+	if query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+	if total := stats.Total(StatsFieldQuantity); total != 6 {
+		t.Errorf("Total = %d, want 6", total)
+	}
+	if series := stats.Series(StatsFieldQuantity); len(series) != 2 || series[0] != 2 || series[1] != 4 {
+		t.Errorf("Series = %v, want [2 4]", series)
+	}
+}
+
+func TestCountIssues(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("project") == "3" {
+			w.Header().Set("X-Hits", "42")
+		}
+		w.Write([]byte(`[{"id":"1"},{"id":"2"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	count, err := client.CountIssues(context.Background(), "acme", &IssueFilter{ProjectIDs: []ID{"3"}})
+	if err != nil || count != 42 || requests != 1 {
+		t.Errorf("CountIssues with X-Hits = %d, %v after %d requests, want 42 after 1", count, err, requests)
+	}
+
+	// Without X-Hits the issues are counted page by page
+	count, err = client.CountIssues(context.Background(), "acme", nil)
+	if err != nil || count != 2 {
+		t.Errorf("CountIssues without X-Hits = %d, %v, want 2", count, err)
+	}
+}
@@ -0,0 +1,105 @@
+package glitchtip
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DSN is a parsed client key DSN, the address SDKs send events to.
+type DSN struct {
+	// Host is the server URL without the key and project, e.g.
+	// "https://glitchtip.example.com".
+	Host      string
+	PublicKey string
+	ProjectID string
+}
+
+// ParseDSN parses a DSN such as "https://key@glitchtip.example.com/1".
+func ParseDSN(dsn string) (*DSN, error) {
+	u, err := url.Parse(dsn)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("invalid DSN %q, expected e.g. https://<key>@glitchtip.example.com/<project_id>", dsn)
+	}
+	prefix, projectID := "", strings.Trim(u.Path, "/")
+	if i := strings.LastIndex(projectID, "/"); i >= 0 {
+		prefix, projectID = "/"+projectID[:i], projectID[i+1:]
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("invalid DSN %q, it has no project ID", dsn)
+	}
+	return &DSN{Host: u.Scheme + "://" + u.Host + prefix, PublicKey: u.User.Username(), ProjectID: projectID}, nil
+}
+
+// storeURL is the endpoint events are sent to.
+func (d *DSN) storeURL() string {
+	return d.Host + "/api/" + pathEscape(d.ProjectID) + "/store/"
+}
+
+// StoreEvent is an event in the format SDKs send it.
+type StoreEvent struct {
+	EventID     string                 `json:"event_id"`
+	Timestamp   string                 `json:"timestamp"`
+	Level       string                 `json:"level"`
+	Platform    string                 `json:"platform"`
+	Logger      string                 `json:"logger,omitempty"`
+	ServerName  string                 `json:"server_name,omitempty"`
+	Release     string                 `json:"release,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	Message     string                 `json:"message"`
+	Tags        map[string]string      `json:"tags,omitempty"`
+	Extra       map[string]interface{} `json:"extra,omitempty"`
+	// Fingerprint groups events into issues, instead of the message
+	Fingerprint []string `json:"fingerprint,omitempty"`
+}
+
+// SendEvent sends an event with a DSN, the way SDKs do, and returns its
+// ID. It needs no API token. The ID, timestamp, level and platform are
+// filled in when empty.
+func (c *Client) SendEvent(ctx context.Context, dsn *DSN, event StoreEvent) (string, error) {
+	if event.EventID == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return "", err
+		}
+		event.EventID = hex.EncodeToString(id)
+	}
+	if event.Timestamp == "" {
+		event.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+	if event.Level == "" {
+		event.Level = "error"
+	}
+	if event.Platform == "" {
+		event.Platform = "other"
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dsn.storeURL(), bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", "Sentry sentry_version=7, sentry_client=glitchtipctl, sentry_key="+dsn.PublicKey)
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if _, err := c.do(req, &response); err != nil {
+		return "", err
+	}
+	if response.ID == "" {
+		response.ID = event.EventID
+	}
+	return response.ID, nil
+}
@@ -0,0 +1,122 @@
+package glitchtip
+
+import "context"
+
+// Team is a group of organization members that owns projects.
+type Team struct {
+	ID          ID        `json:"id"`
+	Slug        string    `json:"slug"`
+	DateCreated string    `json:"dateCreated"`
+	IsMember    bool      `json:"isMember"`
+	MemberCount int       `json:"memberCount"`
+	Projects    []Project `json:"projects,omitempty"`
+}
+
+// TeamCreateRequest is the payload for creating a team.
+type TeamCreateRequest struct {
+	Slug string `json:"slug"`
+}
+
+// TeamUpdateRequest is the payload for updating a team.
+type TeamUpdateRequest struct {
+	Slug string `json:"slug"`
+}
+
+// IterTeams pages through every team the token has access to, across organizations.
+func (c *Client) IterTeams(ctx context.Context, opts *ListOptions) *Iterator[Team] {
+	return newIterator[Team](ctx, c, "teams/", nil, opts)
+}
+
+// ListTeams returns every team the token has access to, across organizations.
+func (c *Client) ListTeams(ctx context.Context, opts *ListOptions) ([]Team, error) {
+	return c.IterTeams(ctx, opts).All()
+}
+
+// IterOrganizationTeams pages through the teams of one organization.
+func (c *Client) IterOrganizationTeams(ctx context.Context, orgSlug string, opts *ListOptions) *Iterator[Team] {
+	return newIterator[Team](ctx, c, organizationPath(orgSlug)+"teams/", nil, opts)
+}
+
+// ListOrganizationTeams returns the teams of one organization.
+func (c *Client) ListOrganizationTeams(ctx context.Context, orgSlug string, opts *ListOptions) ([]Team, error) {
+	return c.IterOrganizationTeams(ctx, orgSlug, opts).All()
+}
+
+// GetTeam returns a single team by organization and team slug.
+func (c *Client) GetTeam(ctx context.Context, orgSlug, teamSlug string) (*Team, error) {
+	var team Team
+	if err := c.get(ctx, teamPath(orgSlug, teamSlug), nil, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// ListTeamMembers returns the organization members that belong to a team.
+func (c *Client) ListTeamMembers(ctx context.Context, orgSlug, teamSlug string) ([]Member, error) {
+	return newIterator[Member](ctx, c, teamPath(orgSlug, teamSlug)+"members/", nil, nil).All()
+}
+
+// CreateTeam creates a team inside an organization.
+func (c *Client) CreateTeam(ctx context.Context, orgSlug string, payload TeamCreateRequest) (*Team, error) {
+	var team Team
+	if err := c.post(ctx, organizationPath(orgSlug)+"teams/", payload, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// UpdateTeam changes a team, which can only be renamed.
+func (c *Client) UpdateTeam(ctx context.Context, orgSlug, teamSlug string, payload TeamUpdateRequest) (*Team, error) {
+	var team Team
+	if err := c.put(ctx, teamPath(orgSlug, teamSlug), payload, &team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// DeleteTeam deletes a team. Its projects are kept.
+func (c *Client) DeleteTeam(ctx context.Context, orgSlug, teamSlug string) error {
+	return c.delete(ctx, teamPath(orgSlug, teamSlug))
+}
+
+// AddTeamMember adds an organization member to a team.
+func (c *Client) AddTeamMember(ctx context.Context, orgSlug, teamSlug, memberID string) error {
+	return c.post(ctx, teamMemberPath(orgSlug, teamSlug, memberID), nil, nil)
+}
+
+// RemoveTeamMember removes a member from a team. The member stays in the
+// organization.
+func (c *Client) RemoveTeamMember(ctx context.Context, orgSlug, teamSlug, memberID string) error {
+	return c.delete(ctx, teamMemberPath(orgSlug, teamSlug, memberID))
+}
+
+// AddTeamProject gives a team access to a project and returns the updated
+// project.
+func (c *Client) AddTeamProject(ctx context.Context, orgSlug, teamSlug, projectSlug string) (*Project, error) {
+	var project Project
+	if err := c.post(ctx, projectTeamPath(orgSlug, projectSlug, teamSlug), nil, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// RemoveTeamProject takes the access to a project away from a team. The
+// project is kept.
+func (c *Client) RemoveTeamProject(ctx context.Context, orgSlug, teamSlug, projectSlug string) error {
+	return c.delete(ctx, projectTeamPath(orgSlug, projectSlug, teamSlug))
+}
+
+// teamMemberPath is the endpoint that adds a member to a team.
+func teamMemberPath(orgSlug, teamSlug, memberID string) string {
+	return memberPath(orgSlug, memberID) + "teams/" + pathEscape(teamSlug) + "/"
+}
+
+// projectTeamPath is the endpoint that assigns a project to a team.
+func projectTeamPath(orgSlug, projectSlug, teamSlug string) string {
+	return projectPath(orgSlug, projectSlug) + "teams/" + pathEscape(teamSlug) + "/"
+}
+
+// teamPath is the detail endpoint of a team.
+func teamPath(orgSlug, teamSlug string) string {
+	return "teams/" + pathEscape(orgSlug) + "/" + pathEscape(teamSlug) + "/"
+}
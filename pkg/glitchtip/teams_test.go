@@ -0,0 +1,53 @@
+package glitchtip
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestTeamAssignments(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"3","slug":"web","teams":[{"id":"1","slug":"ops"},{"id":"2","slug":"backend"}]}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+	ctx := context.Background()
+	if err := client.AddTeamMember(ctx, "acme", "ops", "7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RemoveTeamMember(ctx, "acme", "ops", "7"); err != nil {
+		t.Fatal(err)
+	}
+	project, err := client.AddTeamProject(ctx, "acme", "backend", "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(project.Teams) != 2 || project.Teams[1].Slug != "backend" {
+		t.Errorf("AddTeamProject teams = %+v", project.Teams)
+	}
+	if err := client.RemoveTeamProject(ctx, "acme", "backend", "web"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"POST /api/0/organizations/acme/members/7/teams/ops/",
+		"DELETE /api/0/organizations/acme/members/7/teams/ops/",
+		"POST /api/0/projects/acme/web/teams/backend/",
+		"DELETE /api/0/projects/acme/web/teams/backend/",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
@@ -0,0 +1,43 @@
+package glitchtip
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ID is an object identifier. GlitchTip returns some identifiers as JSON
+// strings and others as numbers, so ID accepts both.
+type ID string
+
+// UnmarshalJSON decodes either a JSON string or a JSON number.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*id = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = ID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+// String returns the identifier as text.
+func (id ID) String() string {
+	return string(id)
+}
+
+// Avatar represents the nested avatar structure
+type Avatar struct {
+	AvatarType string      `json:"avatarType"`
+	AvatarUUID interface{} `json:"avatarUuid"`
+}
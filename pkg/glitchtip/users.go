@@ -0,0 +1,35 @@
+package glitchtip
+
+import "context"
+
+// User is a GlitchTip user account.
+type User struct {
+	ID              ID     `json:"id"`
+	Name            string `json:"name"`
+	Username        string `json:"username"`
+	Email           string `json:"email"`
+	DateJoined      string `json:"dateJoined"`
+	LastLogin       string `json:"lastLogin"`
+	IsActive        bool   `json:"isActive"`
+	IsSuperuser     bool   `json:"isSuperuser"`
+	HasPasswordAuth bool   `json:"hasPasswordAuth"`
+}
+
+// IterOrganizationUsers pages through the user accounts belonging to an organization.
+func (c *Client) IterOrganizationUsers(ctx context.Context, orgSlug string, opts *ListOptions) *Iterator[User] {
+	return newIterator[User](ctx, c, organizationPath(orgSlug)+"users/", nil, opts)
+}
+
+// ListOrganizationUsers returns the user accounts belonging to an organization.
+func (c *Client) ListOrganizationUsers(ctx context.Context, orgSlug string, opts *ListOptions) ([]User, error) {
+	return c.IterOrganizationUsers(ctx, orgSlug, opts).All()
+}
+
+// GetCurrentUser returns the user the API token belongs to.
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.get(ctx, "users/me/", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}